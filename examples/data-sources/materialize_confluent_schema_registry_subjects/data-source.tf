@@ -0,0 +1,20 @@
+data "materialize_confluent_schema_registry_subjects" "orders" {
+  url      = "https://psrc-123.us-east-1.aws.confluent.cloud"
+  username = var.schema_registry_username
+  password = var.schema_registry_password
+  topic    = "orders"
+}
+
+resource "materialize_sink_kafka" "orders" {
+  name         = "orders_sink"
+  cluster_name = "quickstart"
+  topic        = "orders"
+  # ...
+
+  lifecycle {
+    precondition {
+      condition     = data.materialize_confluent_schema_registry_subjects.orders.value_compatibility_level != "NONE"
+      error_message = "The orders-value subject must enforce schema compatibility."
+    }
+  }
+}
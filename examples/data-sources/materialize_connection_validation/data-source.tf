@@ -0,0 +1,29 @@
+data "materialize_connection_validation" "kafka" {
+  name          = "kafka_connection"
+  schema_name   = "schema"
+  database_name = "materialize"
+}
+
+data "materialize_connection_validation" "by_id" {
+  connection_id = "u1234" # The ID of the connection
+}
+
+# Fail the plan if the connection no longer validates
+resource "materialize_source_kafka" "example" {
+  name         = "source_kafka"
+  cluster_name = "quickstart"
+  topic        = "topic1"
+
+  kafka_connection {
+    name          = data.materialize_connection_validation.kafka.name
+    schema_name   = data.materialize_connection_validation.kafka.schema_name
+    database_name = data.materialize_connection_validation.kafka.database_name
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.materialize_connection_validation.kafka.valid
+      error_message = data.materialize_connection_validation.kafka.error
+    }
+  }
+}
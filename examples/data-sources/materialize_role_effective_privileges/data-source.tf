@@ -0,0 +1,12 @@
+data "materialize_role_effective_privileges" "analyst" {
+  role_name = "analyst"
+}
+
+# All tables the role can read, and the role the access comes from
+output "analyst_selectable_tables" {
+  value = [
+    for p in data.materialize_role_effective_privileges.analyst.privileges :
+    "${p.database_name}.${p.schema_name}.${p.object_name} (via ${p.granted_via_role})"
+    if p.kind == "object" && p.object_type == "table" && p.privilege == "SELECT"
+  ]
+}
@@ -0,0 +1,11 @@
+data "materialize_users" "engineering" {
+  email_pattern   = "@example\\.com$"
+  role_name       = "Organization Member"
+  sso_provisioned = true
+}
+
+# Create a SQL role for every engineer
+resource "materialize_role" "engineers" {
+  for_each = { for user in data.materialize_users.engineering.users : user.id => user }
+  name     = each.value.email
+}
@@ -1,5 +1,8 @@
 # Connections can be imported using the connection id:
 terraform import materialize_connection_aws_privatelink.example <region>:<connection_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_connection_aws_privatelink.example <region>:name:<database>.<schema>.<connection_name>
+
 # Connection id and information be found in the `mz_catalog.mz_connections` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
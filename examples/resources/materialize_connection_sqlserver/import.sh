@@ -1,7 +1,11 @@
-#!/bin/bash
+# Connections can be imported using the connection id:
+terraform import materialize_connection_sqlserver.example <region>:<connection_id>
 
-# SQL Server connections can be imported using the connection name
-terraform import materialize_connection_sqlserver.example <region>:<connection_name>
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_connection_sqlserver.example <region>:name:<database>.<schema>.<connection_name>
 
 # Example
-terraform import materialize_connection_sqlserver.example aws/us-east-1:my_sqlserver_connection 
+terraform import materialize_connection_sqlserver.example aws/us-east-1:name:materialize.public.my_sqlserver_connection
+
+# Connection id and information be found in the `mz_catalog.mz_connections` table
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -1,5 +1,8 @@
 # Indexes can be imported using the index id:
 terraform import materialize_index.example_index <region>:<index_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_index.example_index <region>:name:<database>.<schema>.<index_name>
+
 # Index id and information be found in the `mz_catalog.mz_indexes` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,7 @@
+# The system default network policy can be imported using the `terraform import` command.
+terraform import materialize_network_policy_attachment.example <region>:SYSTEM
+
+# The network policy attached to a role can be imported using the role name.
+terraform import materialize_network_policy_attachment.example <region>:ROLE|<role_name>
+
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,20 @@
+resource "materialize_network_policy" "office" {
+  name = "office_policy"
+  rule {
+    name      = "new_york"
+    action    = "allow"
+    direction = "ingress"
+    address   = "8.2.3.4/28"
+  }
+}
+
+# Activate the policy for every role without a network policy of its own
+resource "materialize_network_policy_attachment" "default" {
+  network_policy_name = materialize_network_policy.office.name
+}
+
+# Activate the policy for a single role
+resource "materialize_network_policy_attachment" "analyst" {
+  network_policy_name = materialize_network_policy.office.name
+  role_name           = "analyst"
+}
@@ -7,3 +7,22 @@ resource "materialize_role" "admin_user" {
   password  = var.admin_password
   superuser = true
 }
+
+# Rotate the password of a service role every 90 days
+resource "time_rotating" "app" {
+  rotation_days = 90
+}
+
+resource "random_password" "app" {
+  length = 32
+  keepers = {
+    rotation = time_rotating.app.id
+  }
+}
+
+resource "materialize_role" "app_user" {
+  name                      = "app_user"
+  login                     = true
+  password                  = random_password.app.result
+  password_rotation_trigger = time_rotating.app.id
+}
@@ -0,0 +1,5 @@
+# Role members can be imported using the concatenation of
+# ROLE MEMBERS and the id of the role
+terraform import materialize_role_members.example <region>:ROLE MEMBERS|<role_id>
+
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,14 @@
+# Manage every member of the analyst role. Members granted outside of
+# Terraform are revoked.
+resource "materialize_role_members" "analyst" {
+  role_name = "analyst"
+  members   = ["alice", "bob"]
+  exclusive = true
+}
+
+# Manage some of the members of the reporting role. Members granted outside
+# of Terraform are left in place and reported in unmanaged_members.
+resource "materialize_role_members" "reporting" {
+  role_name = "reporting"
+  members   = ["carol"]
+}
@@ -0,0 +1,6 @@
+# The synchronization can be imported using the `terraform import` command.
+terraform import materialize_scim_group_role_sync.example "<region>:SCIM GROUP ROLE|<group_id>|<role_id>"
+
+# The group_id is the ID of the SCIM group and the role_id is the ID of the SQL role,
+# which can be found in the `mz_catalog.mz_roles` table.
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,11 @@
+# Grant the analyst role to every user of the SCIM group and revoke it from
+# everyone else
+resource "materialize_role" "analyst" {
+  name = "analyst"
+}
+
+resource "materialize_scim_group_role_sync" "analysts" {
+  group_id  = materialize_scim_group.analysts.id
+  role_name = materialize_role.analyst.name
+  exclusive = true
+}
@@ -1,5 +1,8 @@
 # Secrets can be imported using the secret id:
 terraform import materialize_secret.example_secret <region>:<secret_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_secret.example_secret <region>:name:<database>.<schema>.<secret_name>
+
 # Secret id and information be found in the `mz_catalog.mz_secrets` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
@@ -1,5 +1,8 @@
 # Sources can be imported using the source id:
 terraform import materialize_source_load_generator.example_source_load_generator <region>:<source_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_source_load_generator.example_source_load_generator <region>:name:<database>.<schema>.<source_name>
+
 # Source id and information be found in the `mz_catalog.mz_sources` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
@@ -1,5 +1,13 @@
 # Sources can be imported using the source id:
 terraform import materialize_source_postgres.example_source_postgres <region>:<source_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_source_postgres.example_source_postgres <region>:name:<database>.<schema>.<source_name>
+
 # Source id and information be found in the `mz_catalog.mz_sources` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
+
+# The catalog does not record whether a source was created with FOR ALL TABLES
+# or FOR SCHEMAS, so `all_tables` and `schemas` cannot be imported. An imported
+# source lists its subsources in `table`, configure them there to keep the source
+# from being replaced
@@ -61,3 +61,24 @@ resource "materialize_source_postgres" "with_options" {
 # CREATE SOURCE schema.source_postgres_with_options
 #   FROM POSTGRES CONNECTION "database"."schema"."pg_connection" (PUBLICATION 'mz_source', TEXT COLUMNS (public.users.description, public.posts.content), EXCLUDE COLUMNS (public.users.image_data, public.posts.binary_data))
 #   FOR TABLES (public.users AS users, public.posts AS posts);
+
+# PostgreSQL source replicating every table in the given upstream schemas
+resource "materialize_source_postgres" "for_schemas" {
+  name         = "source_postgres_for_schemas"
+  schema_name  = "schema"
+  cluster_name = "quickstart"
+  publication  = "mz_source"
+
+  postgres_connection {
+    name = "pg_connection"
+  }
+
+  # Use `all_tables = true` instead to replicate every table in the publication
+  schemas = ["public", "app"]
+}
+
+# CREATE SOURCE schema.source_postgres_for_schemas
+#   FROM POSTGRES CONNECTION "database"."schema"."pg_connection" (PUBLICATION 'mz_source')
+#   FOR SCHEMAS ("public", "app");
+
+# The created subsources are available as materialize_source_postgres.for_schemas.subsource
@@ -1,7 +1,11 @@
-#!/bin/bash
+# Sources can be imported using the source id:
+terraform import materialize_source_sqlserver.example <region>:<source_id>
 
-# SQL Server sources can be imported using the source name
-terraform import materialize_source_sqlserver.example <region>:<source_name>
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_source_sqlserver.example <region>:name:<database>.<schema>.<source_name>
 
 # Example
-terraform import materialize_source_sqlserver.example aws/us-east-1:my_sqlserver_source
+terraform import materialize_source_sqlserver.example aws/us-east-1:name:materialize.public.my_sqlserver_source
+
+# Source id and information be found in the `mz_catalog.mz_sources` table
+# The region is the region where the database is located (e.g. aws/us-east-1)
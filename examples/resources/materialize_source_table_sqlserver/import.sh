@@ -1,5 +1,8 @@
 # Source tables can be imported using the source table id:
 terraform import materialize_source_table_sqlserver.example_source_table_sqlserver <region>:<source_table_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_source_table_sqlserver.example_source_table_sqlserver <region>:name:<database>.<schema>.<source_table_name>
+
 # Source id and information be found in the `mz_catalog.mz_tables` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
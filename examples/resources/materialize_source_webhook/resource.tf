@@ -31,4 +31,7 @@ resource "materialize_source_webhook" "example_webhook" {
 #   CHECK (
 #     WITH ( HEADERS, SECRET materialize.public.password AS secret)
 #     headers->'x-mz-api-key' = secret
-#   );
\ No newline at end of file
+#   );
+# To rotate the secret used by the check, update the value of
+# materialize_secret.password. The secret is altered in place and the source,
+# along with its url, is left unchanged.
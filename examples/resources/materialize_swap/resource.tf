@@ -0,0 +1,28 @@
+# Blue/green deployment of a schema. Terraform manages both schemas by name,
+# and bumping the version promotes the objects in the green schema.
+resource "materialize_schema" "prod" {
+  name             = "prod"
+  identify_by_name = true
+}
+
+resource "materialize_schema" "prod_deploy" {
+  name             = "prod_deploy"
+  identify_by_name = true
+}
+
+resource "materialize_swap" "prod" {
+  object_type = "SCHEMA"
+  name        = materialize_schema.prod.name
+  swap_with   = materialize_schema.prod_deploy.name
+
+  triggers = {
+    version = "2"
+  }
+}
+
+# Swap two clusters
+resource "materialize_swap" "compute" {
+  object_type = "CLUSTER"
+  name        = "compute"
+  swap_with   = "compute_deploy"
+}
@@ -1,5 +1,8 @@
 # Tables can be imported using the table id:
 terraform import materialize_table.example_table <region>:<table_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_table.example_table <region>:name:<database>.<schema>.<table_name>
+
 # Table id and information be found in the `mz_catalog.mz_tables` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
@@ -1,5 +1,8 @@
 # Types can be imported using the type id:
 terraform import materialize_type.example_type <region>:<type_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_type.example_type <region>:name:<database>.<schema>.<type_name>
+
 # Type id and information be found in the `mz_catalog.mz_types` table
 # The region is the region where the database is located (e.g. aws/us-east-1)
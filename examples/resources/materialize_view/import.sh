@@ -1,5 +1,8 @@
 # Views can be imported using the view id:
 terraform import materialize_view.example_view <region>:<view_id>
 
+# Or using the fully qualified name, which sets `identify_by_name`. Identifiers are folded to lower case unless quoted:
+terraform import materialize_view.example_view <region>:name:<database>.<schema>.<view_name>
+
 # View id and information be found in the `mz_catalog.mz_views`
 # The region is the region where the database is located (e.g. aws/us-east-1)
@@ -116,6 +116,26 @@ resource "materialize_source_postgres" "example_source_postgres" {
   }
 }
 
+resource "materialize_schema" "postgres_for_schemas" {
+  name          = "postgres_for_schemas"
+  database_name = materialize_database.database.name
+}
+
+resource "materialize_source_postgres" "example_source_postgres_for_schemas" {
+  name          = "source_postgres_for_schemas"
+  schema_name   = materialize_schema.postgres_for_schemas.name
+  database_name = materialize_schema.postgres_for_schemas.database_name
+  cluster_name  = materialize_cluster.cluster_source.name
+
+  postgres_connection {
+    name          = materialize_connection_postgres.postgres_connection.name
+    schema_name   = materialize_connection_postgres.postgres_connection.schema_name
+    database_name = materialize_connection_postgres.postgres_connection.database_name
+  }
+  publication = "mz_source"
+  schemas     = ["public"]
+}
+
 resource "materialize_source_kafka" "example_source_kafka_format_text" {
   name         = "source_kafka_text"
   comment      = "source kafka comment"
@@ -1,10 +1,22 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/generate"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/provider"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 )
 
 // Provider documentation generation.
@@ -16,9 +28,85 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := runGenerate(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return provider.Provider(version)
 		},
 	})
+	shutdownTracing()
+}
+
+// shutdownTracing exports the spans that are still buffered before the
+// provider exits.
+func shutdownTracing() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := clients.ShutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] unable to export spans: %s", err)
+	}
+}
+
+// runGenerate configures the provider from the MZ_* environment variables,
+// the same way Terraform would without a provider block, and writes import
+// blocks for the existing objects in the region.
+func runGenerate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	region := flags.String("region", "", "Region to generate from. Defaults to MZ_DEFAULT_REGION.")
+	database := flags.String("database", "", "Only generate this database and the schema-scoped objects in it.")
+	schemaName := flags.String("schema", "", "Only generate schema-scoped objects in this schema.")
+	output := flags.String("output", "", "File to write to. Defaults to stdout.")
+	importsOnly := flags.Bool("imports-only", false, "Only emit import blocks, for use with terraform plan -generate-config-out.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-materialize generate [flags]\n\n")
+		fmt.Fprintf(flags.Output(), "Connects with the provider environment variables (MZ_PASSWORD, MZ_HOST, ...) and emits\nTerraform import blocks and starter configuration for the existing objects.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	config := map[string]interface{}{}
+	if *region != "" {
+		config["default_region"] = *region
+	}
+
+	p := provider.Provider(version)
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		for _, d := range diags {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", d.Summary, d.Detail)
+		}
+		return fmt.Errorf("unable to configure the provider")
+	}
+
+	conn, r, err := utils.GetDBClientFromMeta(p.Meta(), nil)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	defer shutdownTracing()
+
+	return generate.Generate(context.Background(), w, conn, generate.Options{
+		Region:      string(r),
+		Database:    *database,
+		Schema:      *schemaName,
+		ImportsOnly: *importsOnly,
+	})
 }
@@ -61,8 +61,13 @@ func NewCloudAPIClient(fronteggClient *FronteggClient, cloudAPIEndpoint, baseEnd
 func (c *CloudAPIClient) ListCloudProviders(ctx context.Context) ([]CloudProvider, error) {
 	providersEndpoint := fmt.Sprintf("%s/api/cloud-regions", c.Endpoint)
 
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, providersEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to list cloud providers: %v", err)
+	}
+
 	// Reuse the FronteggClient's HTTPClient which already includes the Authorization token.
-	resp, err := c.FronteggClient.HTTPClient.Get(providersEndpoint)
+	resp, err := c.FronteggClient.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error listing cloud providers: %v", err)
 	}
@@ -90,7 +95,12 @@ func (c *CloudAPIClient) ListCloudProviders(ctx context.Context) ([]CloudProvide
 func (c *CloudAPIClient) GetRegionDetails(ctx context.Context, provider CloudProvider) (*CloudRegion, error) {
 	regionEndpoint := fmt.Sprintf("%s/api/region", provider.Url)
 
-	resp, err := c.FronteggClient.HTTPClient.Get(regionEndpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, regionEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to retrieve region details: %v", err)
+	}
+
+	resp, err := c.FronteggClient.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving region details: %v", err)
 	}
@@ -1,28 +1,98 @@
 package clients
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"net/url"
+	"os"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
 	"github.com/jmoiron/sqlx"
 )
 
 type DBClient struct {
 	*sqlx.DB
+
+	// connector opens connections with the credentials of the provider. It is
+	// nil for clients that wrap an existing handle, such as in tests.
+	connector driver.Connector
+
+	// statementLog records and tracing traces the statements run on the
+	// handles returned by Session, attributed to region.
+	statementLog *StatementLog
+	tracing      bool
+	region       Region
+
+	mu       sync.Mutex
+	sessions map[string]*sqlx.DB
+	recorded map[string]*sqlx.DB
 }
 
-func NewDBClient(host, user, password string, port int, database, application_name, version, sslmode string, options map[string]string) (*DBClient, diag.Diagnostics) {
+// RecordStatements records the statements run on the handles returned by
+// Session from now on in log.
+func (c *DBClient) RecordStatements(log *StatementLog, region Region) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statementLog = log
+	c.region = region
+}
+
+// Trace starts a span for every statement run on the handles returned by
+// Session from now on.
+func (c *DBClient) Trace(region Region) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tracing = true
+	c.region = region
+}
+
+// NewDBClientFromConnector creates a client whose connections are opened by connector.
+func NewDBClientFromConnector(connector driver.Connector) *DBClient {
+	return &DBClient{
+		DB:        sqlx.NewDb(sql.OpenDB(connector), "pgx"),
+		connector: connector,
+	}
+}
+
+// SSLConfig holds the certificates used to verify the server and to
+// authenticate the client. Each value is either a file path or inline PEM.
+type SSLConfig struct {
+	RootCert string
+	Cert     string
+	Key      string
+}
+
+func (c SSLConfig) isEmpty() bool {
+	return c.RootCert == "" && c.Cert == "" && c.Key == ""
+}
+
+// NewDBClient creates the client for a region. If tokenSource is set, every new
+// connection authenticates with a fresh token instead of the static password.
+func NewDBClient(host, user, password string, port int, database, application_name, version, sslmode string, ssl SSLConfig, tokenSource TokenSource, options map[string]string) (*DBClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if application_name == "" {
 		application_name = fmt.Sprintf("terraform-provider-materialize v%s", version)
 	}
 
+	// Following libpq, require with a root certificate verifies the server
+	// certificate like verify-ca.
+	if sslmode == "require" && ssl.RootCert != "" {
+		sslmode = "verify-ca"
+	}
+
 	connStr := buildConnectionString(host, user, password, port, database, sslmode, application_name, options)
-	db, err := sqlx.Open("pgx", connStr)
+	connector, err := openConnector(connStr, ssl, tokenSource)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -31,7 +101,229 @@ func NewDBClient(host, user, password string, port int, database, application_na
 		})
 		return nil, diags
 	}
-	return &DBClient{DB: db}, diags
+	return NewDBClientFromConnector(connector), diags
+}
+
+// openConnector parses the connection string upfront, so that invalid settings
+// are reported when the provider is configured rather than on first use.
+func openConnector(connStr string, ssl SSLConfig, tokenSource TokenSource) (driver.Connector, error) {
+	config, err := pgx.ParseConfig(connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ssl.isEmpty() {
+		if err := applySSLConfig(config, ssl); err != nil {
+			return nil, err
+		}
+	}
+
+	var opts []stdlib.OptionOpenDB
+	if tokenSource != nil {
+		opts = append(opts, stdlib.OptionBeforeConnect(func(ctx context.Context, c *pgx.ConnConfig) error {
+			token, err := tokenSource.Token(ctx)
+			if err != nil {
+				return fmt.Errorf("unable to obtain OIDC token: %w", err)
+			}
+			c.Password = token
+			return nil
+		}))
+	}
+
+	return stdlib.GetConnector(*config, opts...), nil
+}
+
+// SessionConfig describes the session a resource runs its statements in.
+type SessionConfig struct {
+	// Role is switched to with SET ROLE, if set
+	Role string
+	// Options are applied with SET
+	Options map[string]string
+}
+
+func (s SessionConfig) IsEmpty() bool {
+	return s.Role == "" && len(s.Options) == 0
+}
+
+// statements returns the statements that set up the session. Values with
+// commas are sent as lists, as with the options connection parameter, so that
+// settings such as search_path can name several schemas.
+func (s SessionConfig) statements() []string {
+	var stmts []string
+	if s.Role != "" {
+		stmts = append(stmts, fmt.Sprintf("SET ROLE %s;", pgx.Identifier{s.Role}.Sanitize()))
+	}
+
+	keys := make([]string, 0, len(s.Options))
+	for k := range s.Options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var values []string
+		for _, v := range strings.Split(s.Options[k], ",") {
+			values = append(values, quoteString(strings.TrimSpace(v)))
+		}
+		stmts = append(stmts, fmt.Sprintf("SET %s = %s;", k, strings.Join(values, ", ")))
+	}
+	return stmts
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// Session returns a handle that runs every statement in the session. It has
+// dedicated connections that are set up as they are opened, so the connections
+// of the provider itself never change settings and need no RESET. The handle
+// is created on first use and shared by every caller with the same session.
+//
+// If statements are recorded, the handle records them on behalf of the
+// resource named on the context they are run with, see ContextWithResource.
+func (c *DBClient) Session(session SessionConfig) (*sqlx.DB, error) {
+	db, key, err := c.sessionDB(session)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.statementLog == nil && !c.tracing {
+		return db, nil
+	}
+
+	// Recording handles forward to the handle of the session, they are
+	// cheap but kept to not start a new pool for every call.
+	if recorded, ok := c.recorded[key]; ok {
+		return recorded, nil
+	}
+	if c.recorded == nil {
+		c.recorded = map[string]*sqlx.DB{}
+	}
+
+	recorded := sqlx.NewDb(sql.OpenDB(&recordingConnector{
+		db:     db.DB,
+		log:    c.statementLog,
+		trace:  c.tracing,
+		region: c.region,
+	}), db.DriverName())
+	c.recorded[key] = recorded
+	return recorded, nil
+}
+
+func (c *DBClient) sessionDB(session SessionConfig) (*sqlx.DB, string, error) {
+	if session.IsEmpty() {
+		return c.DB, "", nil
+	}
+	if c.connector == nil {
+		return nil, "", errors.New("execute_as_role and session_options are not supported by this database client")
+	}
+
+	stmts := session.statements()
+	key := strings.Join(stmts, " ")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if db, ok := c.sessions[key]; ok {
+		return db, key, nil
+	}
+	if c.sessions == nil {
+		c.sessions = map[string]*sqlx.DB{}
+	}
+
+	db := sqlx.NewDb(sql.OpenDB(&sessionConnector{Connector: c.connector, statements: stmts}), "pgx")
+	c.sessions[key] = db
+	return db, key, nil
+}
+
+// sessionConnector sets up the connections opened by the wrapped connector.
+type sessionConnector struct {
+	driver.Connector
+	statements []string
+}
+
+func (c *sessionConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		conn.Close()
+		return nil, errors.New("database driver does not support executing statements")
+	}
+
+	for _, q := range c.statements {
+		if _, err := execer.ExecContext(ctx, q, nil); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("unable to set up session with %s: %w", q, err)
+		}
+	}
+	return conn, nil
+}
+
+// applySSLConfig adds the certificates to every TLS configuration pgx tries,
+// including the fallbacks of sslmode=prefer.
+func applySSLConfig(config *pgx.ConnConfig, ssl SSLConfig) error {
+	if (ssl.Cert == "") != (ssl.Key == "") {
+		return errors.New("both sslcert and sslkey are required for client certificate authentication")
+	}
+
+	var rootCAs *x509.CertPool
+	if ssl.RootCert != "" {
+		pem, err := readPEM(ssl.RootCert)
+		if err != nil {
+			return fmt.Errorf("unable to read sslrootcert: %w", err)
+		}
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return errors.New("unable to add sslrootcert to the certificate pool")
+		}
+	}
+
+	var certificates []tls.Certificate
+	if ssl.Cert != "" {
+		certPEM, err := readPEM(ssl.Cert)
+		if err != nil {
+			return fmt.Errorf("unable to read sslcert: %w", err)
+		}
+		keyPEM, err := readPEM(ssl.Key)
+		if err != nil {
+			return fmt.Errorf("unable to read sslkey: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		certificates = []tls.Certificate{cert}
+	}
+
+	tlsConfigs := []*tls.Config{config.TLSConfig}
+	for _, f := range config.Fallbacks {
+		tlsConfigs = append(tlsConfigs, f.TLSConfig)
+	}
+	for _, c := range tlsConfigs {
+		if c == nil {
+			continue
+		}
+		if rootCAs != nil {
+			c.RootCAs = rootCAs
+		}
+		c.Certificates = certificates
+	}
+	return nil
+}
+
+// readPEM returns the value itself if it is inline PEM, otherwise the contents
+// of the file it points to.
+func readPEM(v string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(v), "-----BEGIN") {
+		return []byte(v), nil
+	}
+	return os.ReadFile(v)
 }
 
 func buildConnectionString(host, user, password string, port int, database, sslmode, application_name string, options map[string]string) string {
@@ -1,8 +1,19 @@
 package clients
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,7 +62,92 @@ func TestConnectionStringBackslashAndSpaceEscaping(t *testing.T) {
 func TestNewDBClientFailure(t *testing.T) {
 	r := require.New(t)
 
-	client, diags := NewDBClient("localhost", "user", "pass", 6875, "database", "tf-provider", "v0.1.0", "invalid-sslmode", nil)
+	client, diags := NewDBClient("localhost", "user", "pass", 6875, "database", "tf-provider", "v0.1.0", "invalid-sslmode", SSLConfig{}, nil, nil)
 	r.NotEmpty(diags)
 	r.Nil(client)
 }
+
+// testCertificate returns a self-signed certificate and its key as PEM.
+func testCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "materialize"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestApplySSLConfigInlinePEM(t *testing.T) {
+	r := require.New(t)
+	cert, key := testCertificate(t)
+
+	config, err := pgx.ParseConfig(buildConnectionString("host", "user", "pass", 6875, "database", "verify-full", "tf", nil))
+	r.NoError(err)
+
+	r.NoError(applySSLConfig(config, SSLConfig{RootCert: cert, Cert: cert, Key: key}))
+	r.NotNil(config.TLSConfig.RootCAs)
+	r.Len(config.TLSConfig.Certificates, 1)
+}
+
+func TestApplySSLConfigFilePaths(t *testing.T) {
+	r := require.New(t)
+	cert, key := testCertificate(t)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	r.NoError(os.WriteFile(certPath, []byte(cert), 0600))
+	r.NoError(os.WriteFile(keyPath, []byte(key), 0600))
+
+	// prefer falls back to a second TLS configuration, both get the certificates
+	config, err := pgx.ParseConfig(buildConnectionString("host", "user", "pass", 6875, "database", "prefer", "tf", nil))
+	r.NoError(err)
+
+	r.NoError(applySSLConfig(config, SSLConfig{RootCert: certPath, Cert: certPath, Key: keyPath}))
+	r.Len(config.TLSConfig.Certificates, 1)
+	r.NotNil(config.TLSConfig.RootCAs)
+}
+
+func TestApplySSLConfigCertWithoutKey(t *testing.T) {
+	r := require.New(t)
+	cert, _ := testCertificate(t)
+
+	config, err := pgx.ParseConfig(buildConnectionString("host", "user", "pass", 6875, "database", "require", "tf", nil))
+	r.NoError(err)
+
+	err = applySSLConfig(config, SSLConfig{Cert: cert})
+	r.ErrorContains(err, "both sslcert and sslkey are required")
+}
+
+func TestApplySSLConfigMissingFile(t *testing.T) {
+	r := require.New(t)
+
+	config, err := pgx.ParseConfig(buildConnectionString("host", "user", "pass", 6875, "database", "verify-full", "tf", nil))
+	r.NoError(err)
+
+	err = applySSLConfig(config, SSLConfig{RootCert: filepath.Join(t.TempDir(), "missing.crt")})
+	r.ErrorContains(err, "unable to read sslrootcert")
+}
+
+func TestNewDBClientWithSSLConfig(t *testing.T) {
+	r := require.New(t)
+	cert, key := testCertificate(t)
+
+	client, diags := NewDBClient("localhost", "user", "pass", 6875, "database", "tf-provider", "v0.1.0", "require", SSLConfig{RootCert: cert, Cert: cert, Key: key}, nil, nil)
+	r.Empty(diags)
+	r.NotNil(client)
+}
@@ -25,21 +25,26 @@ type FronteggClient struct {
 	Endpoint    string
 	TokenExpiry time.Time
 	Password    string
+	// Transport sends the requests, http.DefaultTransport if nil
+	Transport http.RoundTripper
 }
 
-// NewFronteggClient function for initializing a new Frontegg client with an auth token
-func NewFronteggClient(ctx context.Context, password, endpoint string) (*FronteggClient, error) {
-	token, email, tokenExpiry, err := getToken(ctx, password, endpoint)
+// NewFronteggClient function for initializing a new Frontegg client with an auth token.
+// Requests are sent through transport, http.DefaultTransport if nil.
+func NewFronteggClient(ctx context.Context, password, endpoint string, transport http.RoundTripper) (*FronteggClient, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	token, email, tokenExpiry, err := getToken(ctx, &http.Client{Transport: transport}, password, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get token: %v", err)
 	}
 
-	transport := &tokenTransport{
+	client := &http.Client{Transport: &tokenTransport{
 		Token:     token,
-		Transport: http.DefaultTransport,
-	}
-
-	client := &http.Client{Transport: transport}
+		Transport: transport,
+	}}
 
 	return &FronteggClient{
 		HTTPClient:  client,
@@ -48,6 +53,7 @@ func NewFronteggClient(ctx context.Context, password, endpoint string) (*Fronteg
 		Endpoint:    endpoint,
 		TokenExpiry: tokenExpiry.Add(-time.Duration(0.5*float64(time.Until(tokenExpiry).Nanoseconds())) * time.Nanosecond),
 		Password:    password,
+		Transport:   transport,
 	}, nil
 }
 
@@ -65,7 +71,7 @@ func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 }
 
 // GetToken function to authenticate with the Frontegg API and retrieve a token
-func getToken(ctx context.Context, password string, endpoint string) (string, string, time.Time, error) {
+func getToken(ctx context.Context, httpClient *http.Client, password string, endpoint string) (string, string, time.Time, error) {
 	clientId, secretKey, err := parseAppPassword(password)
 	if err != nil {
 		return "", "", time.Time{}, err
@@ -88,7 +94,7 @@ func getToken(ctx context.Context, password string, endpoint string) (string, st
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
@@ -240,17 +246,20 @@ func (c *FronteggClient) RefreshToken() error {
 	// Never log the client itself: it carries the app password and the token.
 	log.Printf("[DEBUG] Refreshing Frontegg token for %s", c.Email)
 
-	token, email, tokenExpiry, err := getToken(context.Background(), c.Password, c.Endpoint)
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	token, email, tokenExpiry, err := getToken(context.Background(), &http.Client{Transport: transport}, c.Password, c.Endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to get token: %v", err)
 	}
 
-	transport := &tokenTransport{
+	client := &http.Client{Transport: &tokenTransport{
 		Token:     token,
-		Transport: http.DefaultTransport,
-	}
-
-	client := &http.Client{Transport: transport}
+		Transport: transport,
+	}}
 
 	c.HTTPClient = client
 	c.Token = token
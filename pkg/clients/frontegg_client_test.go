@@ -34,7 +34,7 @@ func TestNewFronteggClient(t *testing.T) {
 	password := "mzp_" + strings.Repeat("a", 64)
 
 	// Create the Frontegg client using the mocked server and context
-	fronteggClient, err := NewFronteggClient(context.Background(), password, endpoint)
+	fronteggClient, err := NewFronteggClient(context.Background(), password, endpoint, nil)
 	require.NoError(t, err, "Error should be nil")
 	require.NotNil(t, fronteggClient, "Frontegg client should not be nil")
 
@@ -54,7 +54,7 @@ func TestFronteggClient_AuthenticationError(t *testing.T) {
 	password := "mzp_" + strings.Repeat("a", 64)
 
 	// Create the Frontegg client using the mocked server and context
-	_, err := NewFronteggClient(context.Background(), password, endpoint)
+	_, err := NewFronteggClient(context.Background(), password, endpoint, nil)
 	require.Error(t, err, "Authentication error should result in an error")
 }
 
@@ -82,7 +82,7 @@ func TestFronteggClient_TokenRefresh(t *testing.T) {
 	password := "mzp_" + strings.Repeat("a", 64)
 
 	// Create the Frontegg client using the mock server and context
-	fronteggClient, err := NewFronteggClient(context.Background(), password, endpoint)
+	fronteggClient, err := NewFronteggClient(context.Background(), password, endpoint, nil)
 	require.NoError(t, err, "Error should be nil")
 	require.NotNil(t, fronteggClient, "Frontegg client should not be nil")
 
@@ -0,0 +1,76 @@
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// HTTPConfig configures the transport of the requests to the Materialize APIs.
+type HTTPConfig struct {
+	// ProxyURL is the proxy all requests go through. Credentials in the URL
+	// are sent to the proxy. If empty, the HTTPS_PROXY and NO_PROXY
+	// environment variables apply.
+	ProxyURL string
+	// CABundle holds CA certificates trusted in addition to the system ones,
+	// as a file path or inline PEM.
+	CABundle string
+	// TLSMinVersion is the lowest TLS version accepted, 1.2 if empty.
+	TLSMinVersion string
+}
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSVersions lists the values accepted for HTTPConfig.TLSMinVersion.
+var TLSVersions = []string{"1.2", "1.3"}
+
+// NewHTTPTransport builds the transport shared by the Frontegg and Cloud API
+// clients.
+func NewHTTPTransport(config HTTPConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, errors.New("invalid proxy URL: expected a URL such as http://proxy.example.com:3128")
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if config.TLSMinVersion != "" {
+		version, ok := tlsVersions[config.TLSMinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS version %s, expected one of %v", config.TLSMinVersion, TLSVersions)
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if config.CABundle != "" {
+		pem, err := readPEM(config.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("unable to add CA bundle to the certificate pool")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
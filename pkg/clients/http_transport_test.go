@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPTransportProxy(t *testing.T) {
+	r := require.New(t)
+
+	var proxyAuth, requestURI string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		proxyAuth = req.Header.Get("Proxy-Authorization")
+		requestURI = req.RequestURI
+		w.Write([]byte("proxied"))
+	}))
+	defer proxy.Close()
+
+	transport, err := NewHTTPTransport(HTTPConfig{ProxyURL: "http://user:secret@" + proxy.Listener.Addr().String()})
+	r.NoError(err)
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://admin.cloud.materialize.com/identity")
+	r.NoError(err)
+	resp.Body.Close()
+
+	r.Equal("http://admin.cloud.materialize.com/identity", requestURI)
+	r.Equal("Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), proxyAuth)
+}
+
+func TestNewHTTPTransportInvalidProxy(t *testing.T) {
+	r := require.New(t)
+
+	_, err := NewHTTPTransport(HTTPConfig{ProxyURL: "proxy.example.com"})
+	r.ErrorContains(err, "invalid proxy URL")
+}
+
+func TestNewHTTPTransportCABundle(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	// Without the bundle, the certificate of the test server is not trusted
+	transport, err := NewHTTPTransport(HTTPConfig{})
+	r.NoError(err)
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	r.Error(err)
+
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	transport, err = NewHTTPTransport(HTTPConfig{CABundle: string(bundle)})
+	r.NoError(err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	r.NoError(err)
+	resp.Body.Close()
+	r.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func TestNewHTTPTransportTLSMinVersion(t *testing.T) {
+	r := require.New(t)
+
+	transport, err := NewHTTPTransport(HTTPConfig{})
+	r.NoError(err)
+	r.Equal(uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+
+	transport, err = NewHTTPTransport(HTTPConfig{TLSMinVersion: "1.3"})
+	r.NoError(err)
+	r.Equal(uint16(tls.VersionTLS13), transport.TLSClientConfig.MinVersion)
+
+	_, err = NewHTTPTransport(HTTPConfig{TLSMinVersion: "1.0"})
+	r.ErrorContains(err, "unsupported TLS version")
+}
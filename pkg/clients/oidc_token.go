@@ -0,0 +1,220 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenRefreshMargin is how long before its expiry a token is refreshed, so
+// that a token is never presented just as it expires.
+const tokenRefreshMargin = time.Minute
+
+// TokenSource returns the token presented as the password of new SQL connections.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// OIDCConfig configures how the token is obtained, either from an OIDC issuer
+// with the client credentials flow or from a file that is kept up to date by
+// another process, such as a Kubernetes projected service account token.
+type OIDCConfig struct {
+	IssuerURL    string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Audience     string
+	TokenFile    string
+}
+
+// OIDCTokenSource caches the token until shortly before it expires.
+type OIDCTokenSource struct {
+	config     OIDCConfig
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func NewOIDCTokenSource(config OIDCConfig, httpClient *http.Client) (*OIDCTokenSource, error) {
+	if config.TokenFile == "" && config.IssuerURL == "" && config.TokenURL == "" {
+		return nil, errors.New("one of token_file, issuer_url or token_url is required")
+	}
+	if config.TokenFile == "" && config.ClientID == "" {
+		return nil, errors.New("client_id is required for the client credentials flow")
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OIDCTokenSource{config: config, httpClient: httpClient}, nil
+}
+
+func (s *OIDCTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Before(s.expiry.Add(-tokenRefreshMargin)) {
+		return s.token, nil
+	}
+
+	var token string
+	var expiry time.Time
+	var err error
+	if s.config.TokenFile != "" {
+		token, expiry, err = s.readTokenFile()
+	} else {
+		token, expiry, err = s.clientCredentials(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("[DEBUG] obtained OIDC token expiring at %s", expiry)
+	s.token, s.expiry = token, expiry
+	return token, nil
+}
+
+func (s *OIDCTokenSource) readTokenFile() (string, time.Time, error) {
+	b, err := os.ReadFile(s.config.TokenFile)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", time.Time{}, fmt.Errorf("token file %s is empty", s.config.TokenFile)
+	}
+
+	// Tokens without an expiry are not cached, the file is read again for
+	// every new connection.
+	return token, jwtExpiry(token), nil
+}
+
+// jwtExpiry returns the exp claim of the token, without verifying it. The zero
+// time is returned if the token is not a JWT or has no expiry.
+func jwtExpiry(token string) time.Time {
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return time.Time{}
+	}
+	exp, err := parsed.Claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return time.Time{}
+	}
+	return exp.Time
+}
+
+func (s *OIDCTokenSource) clientCredentials(ctx context.Context) (string, time.Time, error) {
+	tokenURL := s.config.TokenURL
+	if tokenURL == "" {
+		discovered, err := s.discoverTokenURL(ctx)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		// Discovery only happens once, the token endpoint does not change
+		s.config.TokenURL = discovered
+		tokenURL = discovered
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {s.config.ClientID},
+		"client_secret": {s.config.ClientSecret},
+	}
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+	if s.config.Audience != "" {
+		form.Set("audience", s.config.Audience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("OIDC token request failed: %s", string(body))
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		IDToken     string `json:"id_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", time.Time{}, fmt.Errorf("decoding OIDC token response failed: %w", err)
+	}
+
+	// Prefer the ID token when the issuer returns one, Materialize
+	// authenticates the identity in it.
+	token := result.IDToken
+	if token == "" {
+		token = result.AccessToken
+	}
+	if token == "" {
+		return "", time.Time{}, errors.New("token not found in the OIDC token response")
+	}
+
+	expiry := jwtExpiry(token)
+	if result.ExpiresIn > 0 {
+		expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return token, expiry, nil
+}
+
+func (s *OIDCTokenSource) discoverTokenURL(ctx context.Context) (string, error) {
+	endpoint := strings.TrimSuffix(s.config.IssuerURL, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("OIDC discovery failed: %s", string(body))
+	}
+
+	var discovery struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return "", fmt.Errorf("decoding OIDC discovery document failed: %w", err)
+	}
+	if discovery.TokenEndpoint == "" {
+		return "", fmt.Errorf("issuer %s does not advertise a token endpoint", s.config.IssuerURL)
+	}
+	return discovery.TokenEndpoint, nil
+}
@@ -0,0 +1,126 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func testJWT(t *testing.T, expiry time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "terraform@example.com",
+		"exp": expiry.Unix(),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func TestOIDCTokenSourceClientCredentials(t *testing.T) {
+	r := require.New(t)
+
+	requests := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{"token_endpoint": server.URL + "/token"})
+		case "/token":
+			requests++
+			r.NoError(req.ParseForm())
+			r.Equal("client_credentials", req.PostForm.Get("grant_type"))
+			r.Equal("terraform", req.PostForm.Get("client_id"))
+			r.Equal("secret", req.PostForm.Get("client_secret"))
+			r.Equal("openid email", req.PostForm.Get("scope"))
+			r.Equal("materialize", req.PostForm.Get("audience"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "access-token",
+				"id_token":     "id-token",
+				"expires_in":   3600,
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s, err := NewOIDCTokenSource(OIDCConfig{
+		IssuerURL:    server.URL,
+		ClientID:     "terraform",
+		ClientSecret: "secret",
+		Scopes:       []string{"openid", "email"},
+		Audience:     "materialize",
+	}, nil)
+	r.NoError(err)
+
+	token, err := s.Token(context.Background())
+	r.NoError(err)
+	r.Equal("id-token", token)
+
+	// The cached token is returned until it is about to expire
+	token, err = s.Token(context.Background())
+	r.NoError(err)
+	r.Equal("id-token", token)
+	r.Equal(1, requests)
+
+	s.expiry = time.Now().Add(tokenRefreshMargin / 2)
+	_, err = s.Token(context.Background())
+	r.NoError(err)
+	r.Equal(2, requests)
+}
+
+func TestOIDCTokenSourceClientCredentialsFailure(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	s, err := NewOIDCTokenSource(OIDCConfig{TokenURL: server.URL, ClientID: "terraform", ClientSecret: "wrong"}, nil)
+	r.NoError(err)
+
+	_, err = s.Token(context.Background())
+	r.ErrorContains(err, "invalid_client")
+}
+
+func TestOIDCTokenSourceTokenFile(t *testing.T) {
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "token")
+	first := testJWT(t, time.Now().Add(30*time.Second))
+	r.NoError(os.WriteFile(path, []byte(first+"\n"), 0600))
+
+	s, err := NewOIDCTokenSource(OIDCConfig{TokenFile: path}, nil)
+	r.NoError(err)
+
+	token, err := s.Token(context.Background())
+	r.NoError(err)
+	r.Equal(first, token)
+
+	// The token expires within the refresh margin, so the file is read again
+	second := testJWT(t, time.Now().Add(time.Hour))
+	r.NoError(os.WriteFile(path, []byte(second), 0600))
+
+	token, err = s.Token(context.Background())
+	r.NoError(err)
+	r.Equal(second, token)
+}
+
+func TestNewOIDCTokenSourceValidation(t *testing.T) {
+	r := require.New(t)
+
+	_, err := NewOIDCTokenSource(OIDCConfig{}, nil)
+	r.ErrorContains(err, "one of token_file, issuer_url or token_url is required")
+
+	_, err = NewOIDCTokenSource(OIDCConfig{IssuerURL: "https://issuer.example.com"}, nil)
+	r.ErrorContains(err, "client_id is required")
+}
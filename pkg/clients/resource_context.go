@@ -0,0 +1,48 @@
+package clients
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ResourceRef names the resource that statements and API requests are run
+// for.
+type ResourceRef struct {
+	// Type is the resource type, such as materialize_view, or
+	// data.<name> for data sources
+	Type string
+	// Object is the qualified name of the object, or its ID if it has no
+	// name, empty until it is known
+	Object string
+	Region string
+}
+
+type resourceRefKey struct{}
+
+// ContextWithResource names the resource that the statements run and the
+// spans started with ctx are for.
+func ContextWithResource(ctx context.Context, ref ResourceRef) context.Context {
+	return context.WithValue(ctx, resourceRefKey{}, ref)
+}
+
+// ResourceFromContext returns the resource named by ContextWithResource.
+func ResourceFromContext(ctx context.Context) (ResourceRef, bool) {
+	ref, ok := ctx.Value(resourceRefKey{}).(ResourceRef)
+	return ref, ok
+}
+
+func resourceAttributes(ctx context.Context) []attribute.KeyValue {
+	ref, ok := ResourceFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	attributes := []attribute.KeyValue{AttributeResource.String(ref.Type)}
+	if ref.Object != "" {
+		attributes = append(attributes, AttributeObject.String(ref.Object))
+	}
+	if ref.Region != "" {
+		attributes = append(attributes, AttributeRegion.String(ref.Region))
+	}
+	return attributes
+}
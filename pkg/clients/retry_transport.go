@@ -0,0 +1,191 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig tunes how requests to the Materialize APIs are retried.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, doubled on every retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between retries, including waits requested
+	// by the server with Retry-After.
+	MaxBackoff time.Duration
+	// RequestTimeout bounds each attempt. Zero means no timeout beyond the
+	// request context.
+	RequestTimeout time.Duration
+}
+
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries:     5,
+	MinBackoff:     500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	RequestTimeout: 60 * time.Second,
+}
+
+// retryTransport retries requests that failed with a rate limit, a server
+// error or a network error, waiting with exponential backoff in between.
+type retryTransport struct {
+	config    RetryConfig
+	transport http.RoundTripper
+	sleep     func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetryTransport wraps transport, http.DefaultTransport if nil, with retries.
+func NewRetryTransport(transport http.RoundTripper, config RetryConfig) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &retryTransport{config: config, transport: transport, sleep: sleepContext}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTripAttempt(req, attempt)
+
+		if attempt >= t.config.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s", req.Method, req.URL.Redacted(), resp.StatusCode, wait)
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s", req.Method, req.URL.Redacted(), err, wait)
+		}
+
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *retryTransport) roundTripAttempt(req *http.Request, attempt int) (*http.Response, error) {
+	r := req
+	if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("unable to retry request: body cannot be replayed")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r = req.Clone(req.Context())
+		r.Body = body
+	}
+
+	if t.config.RequestTimeout <= 0 {
+		return t.transport.RoundTrip(r)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), t.config.RequestTimeout)
+	resp, err := t.transport.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The timeout also covers reading the body, so it is only released once
+	// the caller closes it.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// shouldRetry reports whether the attempt can be retried. Server and network
+// errors are retried for idempotent methods. Other methods are only retried
+// when the request was not processed, which rate limits and 503s say. A 502
+// or 504 may come from a gateway that gave up on a request the API went on to
+// apply, so they are only retried when the response asks for it with
+// Retry-After.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		if resp.Header.Get("Retry-After") != "" {
+			return true
+		}
+	}
+	return resp.StatusCode >= 500 && isIdempotent(req.Method)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the wait before the next attempt, honouring Retry-After.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.config.MaxBackoff)
+		}
+	}
+
+	wait := time.Duration(float64(t.config.MinBackoff) * math.Pow(2, float64(attempt)))
+	if wait <= 0 || wait > t.config.MaxBackoff {
+		wait = t.config.MaxBackoff
+	}
+	// Jitter spreads out retries of concurrent requests hitting the same limit
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// parseRetryAfter accepts both forms of the header, a number of seconds or an
+// HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
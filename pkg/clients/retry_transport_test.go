@@ -0,0 +1,226 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testRetryTransport records the waits instead of sleeping.
+func testRetryTransport(config RetryConfig) (*retryTransport, *[]time.Duration) {
+	waits := []time.Duration{}
+	t := NewRetryTransport(nil, config).(*retryTransport)
+	t.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return t, &waits
+}
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	r := require.New(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	transport, waits := testRetryTransport(DefaultRetryConfig)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	r.NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	r.NoError(err)
+	r.Equal("ok", string(body))
+	r.Equal(3, attempts)
+	r.Equal([]time.Duration{2 * time.Second, 2 * time.Second}, *waits)
+}
+
+func TestRetryTransportReplaysBody(t *testing.T) {
+	r := require.New(t)
+
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		b, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	transport, _ := testRetryTransport(DefaultRetryConfig)
+	resp, err := (&http.Client{Transport: transport}).Post(server.URL, "application/json", bytes.NewBufferString(`{"email":"user@example.com"}`))
+	r.NoError(err)
+	resp.Body.Close()
+
+	r.Equal(http.StatusCreated, resp.StatusCode)
+	r.Equal([]string{`{"email":"user@example.com"}`, `{"email":"user@example.com"}`}, bodies)
+}
+
+func TestRetryTransportDoesNotRetryNonIdempotentServerError(t *testing.T) {
+	r := require.New(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	transport, _ := testRetryTransport(DefaultRetryConfig)
+	resp, err := (&http.Client{Transport: transport}).Post(server.URL, "application/json", bytes.NewBufferString(`{}`))
+	r.NoError(err)
+	resp.Body.Close()
+
+	r.Equal(http.StatusInternalServerError, resp.StatusCode)
+	r.Equal(1, attempts)
+}
+
+func TestRetryTransportNonIdempotentGatewayError(t *testing.T) {
+	r := require.New(t)
+
+	for _, retryAfter := range []string{"", "1"} {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			attempts++
+			if attempts == 1 {
+				if retryAfter != "" {
+					w.Header().Set("Retry-After", retryAfter)
+				}
+				w.WriteHeader(http.StatusGatewayTimeout)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		transport, _ := testRetryTransport(DefaultRetryConfig)
+		resp, err := (&http.Client{Transport: transport}).Post(server.URL, "application/json", bytes.NewBufferString(`{}`))
+		r.NoError(err)
+		resp.Body.Close()
+		server.Close()
+
+		// The request may have been applied behind the gateway, so it is
+		// only repeated when the server asks for it
+		if retryAfter == "" {
+			r.Equal(http.StatusGatewayTimeout, resp.StatusCode)
+			r.Equal(1, attempts)
+		} else {
+			r.Equal(http.StatusCreated, resp.StatusCode)
+			r.Equal(2, attempts)
+		}
+	}
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	r := require.New(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	config := DefaultRetryConfig
+	config.MaxRetries = 2
+	transport, waits := testRetryTransport(config)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	r.NoError(err)
+	resp.Body.Close()
+
+	r.Equal(http.StatusBadGateway, resp.StatusCode)
+	r.Equal(3, attempts)
+	r.Len(*waits, 2)
+	for i, wait := range *waits {
+		// Exponential backoff with jitter between half and the full wait
+		full := config.MinBackoff << i
+		r.GreaterOrEqual(wait, full/2)
+		r.LessOrEqual(wait, full)
+	}
+}
+
+func TestRetryTransportRequestTimeout(t *testing.T) {
+	r := require.New(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts == 1 {
+			select {
+			case <-req.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	config := DefaultRetryConfig
+	config.RequestTimeout = 50 * time.Millisecond
+	transport, _ := testRetryTransport(config)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	r.NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	r.NoError(err)
+	r.Equal("ok", string(body))
+	r.Equal(2, attempts)
+}
+
+func TestRetryTransportContextCanceled(t *testing.T) {
+	r := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := NewRetryTransport(nil, DefaultRetryConfig).(*retryTransport)
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		cancel()
+		return sleepContext(ctx, d)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	r.NoError(err)
+	_, err = (&http.Client{Transport: transport}).Do(req)
+	r.ErrorIs(err, context.Canceled)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	r := require.New(t)
+
+	wait, ok := parseRetryAfter("10")
+	r.True(ok)
+	r.Equal(10*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	r.True(ok)
+	r.InDelta(time.Minute, wait, float64(2*time.Second))
+
+	_, ok = parseRetryAfter("soon")
+	r.False(ok)
+
+	_, ok = parseRetryAfter("")
+	r.False(ok)
+}
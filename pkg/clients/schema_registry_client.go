@@ -0,0 +1,257 @@
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const schemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+// Error codes returned by the Confluent Schema Registry API that the client
+// treats as "not found" rather than as failures.
+const (
+	schemaRegistrySubjectNotFound            = 40401
+	schemaRegistryVersionNotFound            = 40402
+	schemaRegistrySubjectCompatibilityNotSet = 40408
+)
+
+// SchemaRegistryConfig holds the connection details for a Confluent Schema
+// Registry. The fields mirror the ones accepted by
+// materialize_connection_confluent_schema_registry, but hold the plain values
+// instead of references to Materialize secrets.
+type SchemaRegistryConfig struct {
+	URL                     string
+	Username                string
+	Password                string
+	SSLCertificateAuthority string
+	SSLCertificate          string
+	SSLKey                  string
+}
+
+// SchemaRegistryClient is a minimal client for the Confluent Schema Registry
+// REST API, used to inspect subjects and compatibility levels.
+type SchemaRegistryClient struct {
+	HTTPClient *http.Client
+	Endpoint   string
+	Username   string
+	Password   string
+}
+
+// SchemaRegistryError is the error payload returned by the registry.
+type SchemaRegistryError struct {
+	StatusCode int    `json:"-"`
+	ErrorCode  int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *SchemaRegistryError) Error() string {
+	return fmt.Sprintf("schema registry returned status code %d (error code %d): %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+// SchemaRegistrySchema is a single registered schema version.
+type SchemaRegistrySchema struct {
+	Subject    string `json:"subject"`
+	ID         int    `json:"id"`
+	Version    int    `json:"version"`
+	SchemaType string `json:"schemaType"`
+	Schema     string `json:"schema"`
+}
+
+type schemaRegistryConfigResponse struct {
+	CompatibilityLevel string `json:"compatibilityLevel"`
+	// Some registry implementations return the level under `compatibility`.
+	Compatibility string `json:"compatibility"`
+}
+
+func (r schemaRegistryConfigResponse) level() string {
+	if r.CompatibilityLevel != "" {
+		return r.CompatibilityLevel
+	}
+	return r.Compatibility
+}
+
+// NewSchemaRegistryClient creates a new Schema Registry client
+func NewSchemaRegistryClient(config SchemaRegistryConfig) (*SchemaRegistryClient, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("schema registry URL is required")
+	}
+
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema registry URL: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid schema registry URL %q: scheme must be http or https", config.URL)
+	}
+
+	if (config.SSLCertificate == "") != (config.SSLKey == "") {
+		return nil, fmt.Errorf("ssl_certificate and ssl_key must be set together")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.SSLCertificateAuthority != "" || config.SSLCertificate != "" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+		if config.SSLCertificateAuthority != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(config.SSLCertificateAuthority)) {
+				return nil, fmt.Errorf("unable to parse ssl_certificate_authority: no PEM certificates found")
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		if config.SSLCertificate != "" {
+			cert, err := tls.X509KeyPair([]byte(config.SSLCertificate), []byte(config.SSLKey))
+			if err != nil {
+				return nil, fmt.Errorf("unable to load client certificate: %v", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &SchemaRegistryClient{
+		HTTPClient: &http.Client{Transport: transport},
+		Endpoint:   strings.TrimSuffix(config.URL, "/"),
+		Username:   config.Username,
+		Password:   config.Password,
+	}, nil
+}
+
+func (c *SchemaRegistryClient) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	endpoint := c.Endpoint + path
+	if len(query) > 0 {
+		endpoint = endpoint + "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("error creating schema registry request: %v", err)
+	}
+	req.Header.Set("Accept", schemaRegistryContentType)
+	if c.Username != "" || c.Password != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error calling schema registry: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		srErr := &SchemaRegistryError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(body, srErr); err != nil || srErr.Message == "" {
+			srErr.Message = string(body)
+		}
+		return srErr
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error decoding schema registry response: %v", err)
+	}
+	return nil
+}
+
+// ListSubjects returns all subjects registered in the schema registry.
+func (c *SchemaRegistryClient) ListSubjects(ctx context.Context) ([]string, error) {
+	var subjects []string
+	if err := c.get(ctx, "/subjects", nil, &subjects); err != nil {
+		return nil, err
+	}
+	return subjects, nil
+}
+
+// ListVersions returns the registered versions of a subject. A subject that
+// does not exist returns an empty slice.
+func (c *SchemaRegistryClient) ListVersions(ctx context.Context, subject string) ([]int, error) {
+	var versions []int
+	err := c.get(ctx, fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject)), nil, &versions)
+	if IsSchemaRegistryNotFound(err) {
+		return []int{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// GetLatestSchema returns the latest registered version of a subject, or nil
+// if the subject does not exist.
+func (c *SchemaRegistryClient) GetLatestSchema(ctx context.Context, subject string) (*SchemaRegistrySchema, error) {
+	var s SchemaRegistrySchema
+	err := c.get(ctx, fmt.Sprintf("/subjects/%s/versions/latest", url.PathEscape(subject)), nil, &s)
+	if IsSchemaRegistryNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s.SchemaType == "" {
+		// The registry omits the type for Avro schemas.
+		s.SchemaType = "AVRO"
+	}
+	return &s, nil
+}
+
+// GetGlobalCompatibility returns the registry-wide compatibility level.
+func (c *SchemaRegistryClient) GetGlobalCompatibility(ctx context.Context) (string, error) {
+	var r schemaRegistryConfigResponse
+	if err := c.get(ctx, "/config", nil, &r); err != nil {
+		return "", err
+	}
+	return r.level(), nil
+}
+
+// GetSubjectCompatibility returns the compatibility level that applies to a
+// subject, falling back to the global level when the subject has no override.
+func (c *SchemaRegistryClient) GetSubjectCompatibility(ctx context.Context, subject string) (string, error) {
+	var r schemaRegistryConfigResponse
+	q := url.Values{"defaultToGlobal": {"true"}}
+	err := c.get(ctx, fmt.Sprintf("/config/%s", url.PathEscape(subject)), q, &r)
+	if IsSchemaRegistryNotFound(err) {
+		return c.GetGlobalCompatibility(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+	if r.level() == "" {
+		return c.GetGlobalCompatibility(ctx)
+	}
+	return r.level(), nil
+}
+
+// IsSchemaRegistryNotFound reports whether err is a registry "not found"
+// response for a subject, version or subject-level config. Only the registry
+// error codes are trusted, a plain 404 may come from a proxy or a wrong URL.
+func IsSchemaRegistryNotFound(err error) bool {
+	var srErr *SchemaRegistryError
+	if !errors.As(err, &srErr) {
+		return false
+	}
+	switch srErr.ErrorCode {
+	case schemaRegistrySubjectNotFound, schemaRegistryVersionNotFound, schemaRegistrySubjectCompatibilityNotSet:
+		return true
+	}
+	return false
+}
+
+// TopicSubjects returns the key and value subject names for a topic under the
+// default TopicNameStrategy.
+func TopicSubjects(topic string) (string, string) {
+	return topic + "-key", topic + "-value"
+}
@@ -0,0 +1,120 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSchemaRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, p, ok := r.BasicAuth()
+		if !ok || u != "user" || p != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error_code":40101,"message":"Unauthorized"}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/subjects":
+			w.Write([]byte(`["orders-value","orders-key"]`))
+		case "/subjects/orders-value/versions":
+			w.Write([]byte(`[1,2]`))
+		case "/subjects/orders-value/versions/latest":
+			w.Write([]byte(`{"subject":"orders-value","id":7,"version":2,"schema":"{}"}`))
+		case "/subjects/missing/versions":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":40401,"message":"Subject 'missing' not found."}`))
+		case "/config":
+			w.Write([]byte(`{"compatibilityLevel":"BACKWARD"}`))
+		case "/config/orders-value":
+			w.Write([]byte(`{"compatibilityLevel":"FULL"}`))
+		case "/config/orders-key":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":40408,"message":"Subject 'orders-key' does not have subject-level compatibility configured"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`internal error`))
+		}
+	}))
+}
+
+func TestSchemaRegistryClient(t *testing.T) {
+	r := require.New(t)
+	server := newTestSchemaRegistry(t)
+	defer server.Close()
+
+	c, err := NewSchemaRegistryClient(SchemaRegistryConfig{URL: server.URL + "/", Username: "user", Password: "pass"})
+	r.NoError(err)
+	ctx := context.Background()
+
+	subjects, err := c.ListSubjects(ctx)
+	r.NoError(err)
+	r.ElementsMatch([]string{"orders-key", "orders-value"}, subjects)
+
+	versions, err := c.ListVersions(ctx, "orders-value")
+	r.NoError(err)
+	r.Equal([]int{1, 2}, versions)
+
+	versions, err = c.ListVersions(ctx, "missing")
+	r.NoError(err)
+	r.Empty(versions)
+
+	latest, err := c.GetLatestSchema(ctx, "orders-value")
+	r.NoError(err)
+	r.Equal(7, latest.ID)
+	r.Equal(2, latest.Version)
+	r.Equal("AVRO", latest.SchemaType)
+
+	level, err := c.GetSubjectCompatibility(ctx, "orders-value")
+	r.NoError(err)
+	r.Equal("FULL", level)
+
+	// Falls back to the global level when the subject has no override
+	level, err = c.GetSubjectCompatibility(ctx, "orders-key")
+	r.NoError(err)
+	r.Equal("BACKWARD", level)
+}
+
+func TestSchemaRegistryClientErrors(t *testing.T) {
+	r := require.New(t)
+	server := newTestSchemaRegistry(t)
+	defer server.Close()
+
+	c, err := NewSchemaRegistryClient(SchemaRegistryConfig{URL: server.URL, Username: "user", Password: "wrong"})
+	r.NoError(err)
+
+	_, err = c.ListSubjects(context.Background())
+	r.Error(err)
+	var srErr *SchemaRegistryError
+	r.ErrorAs(err, &srErr)
+	r.Equal(http.StatusUnauthorized, srErr.StatusCode)
+	r.Equal(40101, srErr.ErrorCode)
+	r.False(IsSchemaRegistryNotFound(err))
+
+	_, err = NewSchemaRegistryClient(SchemaRegistryConfig{URL: "ftp://registry"})
+	r.Error(err)
+
+	_, err = NewSchemaRegistryClient(SchemaRegistryConfig{URL: server.URL, SSLCertificate: "cert"})
+	r.Error(err)
+
+	_, err = NewSchemaRegistryClient(SchemaRegistryConfig{URL: server.URL, SSLCertificateAuthority: "not a pem"})
+	r.Error(err)
+}
+
+func TestIsSchemaRegistryNotFound(t *testing.T) {
+	r := require.New(t)
+
+	for _, code := range []int{40401, 40402, 40408} {
+		r.True(IsSchemaRegistryNotFound(&SchemaRegistryError{StatusCode: http.StatusNotFound, ErrorCode: code}), code)
+	}
+
+	// A 404 without a registry error code does not mean the subject is missing
+	r.False(IsSchemaRegistryNotFound(&SchemaRegistryError{StatusCode: http.StatusNotFound}))
+	r.False(IsSchemaRegistryNotFound(&SchemaRegistryError{StatusCode: http.StatusNotFound, ErrorCode: 40403}))
+	r.False(IsSchemaRegistryNotFound(errors.New("not found")))
+}
@@ -0,0 +1,325 @@
+package clients
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	StatementSuccess = "success"
+	StatementError   = "error"
+	StatementDryRun  = "dry_run"
+)
+
+// StatementRecord is a line of the statement log. Resource and Object name the
+// resource the statement was run for, as set with ContextWithResource.
+type StatementRecord struct {
+	Time       time.Time `json:"time"`
+	Resource   string    `json:"resource,omitempty"`
+	Object     string    `json:"object,omitempty"`
+	Region     string    `json:"region"`
+	Statement  string    `json:"statement"`
+	DurationMs float64   `json:"duration_ms"`
+	Outcome    string    `json:"outcome"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// StatementLog writes the statements run by the provider as JSON lines, so that
+// every apply leaves a reviewable record of the SQL it ran. With DryRun the
+// statements are only recorded, not run. Queries that read the catalog are not
+// recorded and always run.
+type StatementLog struct {
+	DryRun bool
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStatementLog appends to the file at path, creating it if needed.
+func NewStatementLog(path string, dryRun bool) (*StatementLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open statement log: %w", err)
+	}
+	return &StatementLog{DryRun: dryRun, w: f}, nil
+}
+
+func (l *StatementLog) Record(r StatementRecord) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		log.Printf("[WARN] unable to encode statement log record: %s", err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] unable to write statement log: %s", err)
+	}
+}
+
+// redacted replaces the string literals of secret values and passwords in the
+// recorded and traced statements.
+const redacted = "'<redacted>'"
+
+var (
+	secretStatement = regexp.MustCompile(`(?i)^\s*(CREATE|ALTER)\s+SECRET\s`)
+	secretValue     = regexp.MustCompile(`(?is)(\sAS\s+)'(?:[^']|'')*'`)
+	passwordValue   = regexp.MustCompile(`(?i)(\bPASSWORD\s+)'(?:[^']|'')*'`)
+)
+
+// redactStatement removes the credentials a statement carries as literals, the
+// value of CREATE SECRET and ALTER SECRET and the PASSWORD of roles, so they
+// never reach the statement log or a trace.
+func redactStatement(statement string) string {
+	if secretStatement.MatchString(statement) {
+		statement = secretValue.ReplaceAllString(statement, "${1}"+redacted)
+	}
+	return passwordValue.ReplaceAllString(statement, "${1}"+redacted)
+}
+
+// recordingConnector hands out connections that record their statements in
+// log and trace them, when set, and run them on db, which keeps the
+// connections and session of the handle.
+type recordingConnector struct {
+	db     *sql.DB
+	log    *StatementLog
+	trace  bool
+	region Region
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{connector: c}, nil
+}
+
+func (c *recordingConnector) Driver() driver.Driver { return recordingDriver{} }
+
+type recordingDriver struct{}
+
+func (recordingDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("recording connections cannot be opened by name")
+}
+
+type recordingConn struct {
+	connector *recordingConnector
+	// tx is the transaction in progress, statements run in it while it is set
+	tx *sql.Tx
+	// txCtx is the context the transaction was started with, its COMMIT or
+	// ROLLBACK is recorded for the same resource
+	txCtx context.Context
+}
+
+func (c *recordingConn) dryRun() bool {
+	return c.connector.log != nil && c.connector.log.DryRun
+}
+
+func (c *recordingConn) record(ctx context.Context, statement string, start time.Time, outcome string, err error) {
+	if c.connector.log == nil {
+		return
+	}
+
+	ref, _ := ResourceFromContext(ctx)
+	r := StatementRecord{
+		Time:       start.UTC(),
+		Resource:   ref.Type,
+		Object:     ref.Object,
+		Region:     string(c.connector.region),
+		Statement:  redactStatement(statement),
+		DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+		Outcome:    outcome,
+	}
+	if err != nil {
+		r.Outcome = StatementError
+		r.Error = err.Error()
+	}
+	c.connector.log.Record(r)
+}
+
+// startSpan starts the span of a statement, it returns ctx and a no-op span
+// when the statements are not traced.
+func (c *recordingConn) startSpan(ctx context.Context, statement string) (context.Context, trace.Span) {
+	if !c.connector.trace {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	attributes := []attribute.KeyValue{
+		attribute.String("db.system.name", "materialize"),
+		attribute.String("db.operation.name", statementOperation(statement)),
+		attribute.String("db.query.text", redactStatement(statement)),
+		AttributeRegion.String(string(c.connector.region)),
+	}
+	if ref, ok := ResourceFromContext(ctx); ok {
+		attributes = append(attributes, AttributeResource.String(ref.Type))
+		if ref.Object != "" {
+			attributes = append(attributes, AttributeObject.String(ref.Object))
+		}
+	}
+	return Tracer().Start(ctx, statementOperation(statement),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ctx, span := c.startSpan(ctx, query)
+	start := time.Now()
+	if c.dryRun() {
+		span.SetAttributes(attribute.Bool("materialize.dry_run", true))
+		endSpan(span, nil)
+		c.record(ctx, query, start, StatementDryRun, nil)
+		return driver.RowsAffected(0), nil
+	}
+
+	var result sql.Result
+	var err error
+	if c.tx != nil {
+		result, err = c.tx.ExecContext(ctx, query, driverArgs(args)...)
+	} else {
+		result, err = c.connector.db.ExecContext(ctx, query, driverArgs(args)...)
+	}
+	endSpan(span, err)
+	c.record(ctx, query, start, StatementSuccess, err)
+	return result, err
+}
+
+// QueryContext runs queries even in a dry run, they only read the catalog. The
+// span of a query ends once the query returned, reading the rows is not
+// included.
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	ctx, span := c.startSpan(ctx, query)
+
+	var rows *sql.Rows
+	var err error
+	if c.tx != nil {
+		rows, err = c.tx.QueryContext(ctx, query, driverArgs(args)...)
+	} else {
+		rows, err = c.connector.db.QueryContext(ctx, query, driverArgs(args)...)
+	}
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &forwardedRows{rows: rows}, nil
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *recordingConn) Close() error { return nil }
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *recordingConn) BeginTx(ctx context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	start := time.Now()
+	if c.dryRun() {
+		c.record(ctx, "BEGIN;", start, StatementDryRun, nil)
+		c.txCtx = ctx
+		return &recordingTx{conn: c}, nil
+	}
+
+	tx, err := c.connector.db.BeginTx(ctx, nil)
+	c.record(ctx, "BEGIN;", start, StatementSuccess, err)
+	if err != nil {
+		return nil, err
+	}
+	c.tx = tx
+	c.txCtx = ctx
+	return &recordingTx{conn: c}, nil
+}
+
+type recordingTx struct {
+	conn *recordingConn
+}
+
+func (t *recordingTx) Commit() error {
+	return t.end("COMMIT;", func(tx *sql.Tx) error { return tx.Commit() })
+}
+
+func (t *recordingTx) Rollback() error {
+	return t.end("ROLLBACK;", func(tx *sql.Tx) error { return tx.Rollback() })
+}
+
+func (t *recordingTx) end(statement string, end func(*sql.Tx) error) error {
+	start := time.Now()
+	tx, ctx := t.conn.tx, t.conn.txCtx
+	t.conn.tx, t.conn.txCtx = nil, nil
+	if tx == nil {
+		t.conn.record(ctx, statement, start, StatementDryRun, nil)
+		return nil
+	}
+
+	err := end(tx)
+	t.conn.record(ctx, statement, start, StatementSuccess, err)
+	return err
+}
+
+func driverArgs(args []driver.NamedValue) []interface{} {
+	values := make([]interface{}, len(args))
+	for i, a := range args {
+		if a.Name != "" {
+			values[i] = sql.Named(a.Name, a.Value)
+		} else {
+			values[i] = a.Value
+		}
+	}
+	return values
+}
+
+// forwardedRows returns the rows of a query run on another handle.
+type forwardedRows struct {
+	rows *sql.Rows
+}
+
+func (r *forwardedRows) Columns() []string {
+	columns, _ := r.rows.Columns()
+	return columns
+}
+
+func (r *forwardedRows) Close() error { return r.rows.Close() }
+
+func (r *forwardedRows) Next(dest []driver.Value) error {
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	values := make([]interface{}, len(dest))
+	pointers := make([]interface{}, len(dest))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	if err := r.rows.Scan(pointers...); err != nil {
+		return err
+	}
+	for i, v := range values {
+		dest[i] = v
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func statementRecords(t *testing.T, buf *bytes.Buffer) []StatementRecord {
+	var records []StatementRecord
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var r StatementRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &r))
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestStatementLogRecordsStatements(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	var buf bytes.Buffer
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.RecordStatements(&StatementLog{w: &buf}, Region("aws/us-east-1"))
+
+	mock.ExpectExec(`CREATE SCHEMA "database"."schema";`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT id FROM mz_schemas`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("u1"))
+	mock.ExpectExec(`DROP SCHEMA "database"."schema";`).WillReturnError(errors.New("schema is in use"))
+
+	conn, err := client.Session(SessionConfig{})
+	r.NoError(err)
+
+	ctx := ContextWithResource(context.Background(), ResourceRef{Type: "materialize_schema", Object: `"database"."schema"`})
+	_, err = conn.ExecContext(ctx, `CREATE SCHEMA "database"."schema";`)
+	r.NoError(err)
+	var id string
+	r.NoError(conn.GetContext(ctx, &id, `SELECT id FROM mz_schemas;`))
+	r.Equal("u1", id)
+	_, err = conn.ExecContext(ctx, `DROP SCHEMA "database"."schema";`)
+	r.ErrorContains(err, "schema is in use")
+	r.NoError(mock.ExpectationsWereMet())
+
+	// Queries are not recorded
+	records := statementRecords(t, &buf)
+	r.Len(records, 2)
+	r.Equal("materialize_schema", records[0].Resource)
+	r.Equal(`"database"."schema"`, records[0].Object)
+	r.Equal("aws/us-east-1", records[0].Region)
+	r.Equal(`CREATE SCHEMA "database"."schema";`, records[0].Statement)
+	r.Equal(StatementSuccess, records[0].Outcome)
+	r.Empty(records[0].Error)
+	r.Equal(`DROP SCHEMA "database"."schema";`, records[1].Statement)
+	r.Equal(StatementError, records[1].Outcome)
+	r.Equal("schema is in use", records[1].Error)
+}
+
+func TestStatementLogDryRun(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	var buf bytes.Buffer
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.RecordStatements(&StatementLog{DryRun: true, w: &buf}, Region("aws/us-east-1"))
+
+	// Only queries reach the database
+	mock.ExpectQuery(`SELECT id FROM mz_views`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	conn, err := client.Session(SessionConfig{})
+	r.NoError(err)
+
+	ctx := ContextWithResource(context.Background(), ResourceRef{Type: "materialize_view", Object: `"database"."schema"."view"`})
+	tx, err := conn.BeginTxx(ctx, nil)
+	r.NoError(err)
+	_, err = tx.ExecContext(ctx, `CREATE VIEW "database"."schema"."view" AS SELECT 1;`)
+	r.NoError(err)
+	r.NoError(tx.Commit())
+
+	var ids []string
+	r.NoError(conn.Select(&ids, `SELECT id FROM mz_views;`))
+	r.Empty(ids)
+	r.NoError(mock.ExpectationsWereMet())
+
+	records := statementRecords(t, &buf)
+	r.Len(records, 3)
+	for i, statement := range []string{"BEGIN;", `CREATE VIEW "database"."schema"."view" AS SELECT 1;`, "COMMIT;"} {
+		r.Equal(statement, records[i].Statement)
+		r.Equal(StatementDryRun, records[i].Outcome)
+		r.Equal("materialize_view", records[i].Resource)
+		r.Equal(`"database"."schema"."view"`, records[i].Object)
+	}
+}
+
+func TestStatementLogRedactsCredentials(t *testing.T) {
+	r := require.New(t)
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	var buf bytes.Buffer
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.RecordStatements(&StatementLog{w: &buf}, Region("aws/us-east-1"))
+
+	statements := []string{
+		`CREATE SECRET "database"."schema"."secret" AS 'c2VjcmV0''s value';`,
+		`ALTER SECRET "database"."schema"."secret" AS 'c2VjcmV0''s value';`,
+		`CREATE ROLE "role" WITH LOGIN PASSWORD 'c2VjcmV0''s value';`,
+		`ALTER ROLE "role" WITH PASSWORD 'c2VjcmV0''s value';`,
+	}
+	for range statements {
+		mock.ExpectExec(`c2VjcmV0`).WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+
+	conn, err := client.Session(SessionConfig{})
+	r.NoError(err)
+	for _, statement := range statements {
+		_, err = conn.Exec(statement)
+		r.NoError(err)
+	}
+	r.NoError(mock.ExpectationsWereMet())
+
+	// The statements run with their values, only the log is redacted
+	r.NotContains(buf.String(), "c2VjcmV0")
+	records := statementRecords(t, &buf)
+	r.Len(records, 4)
+	r.Equal(`CREATE SECRET "database"."schema"."secret" AS '<redacted>';`, records[0].Statement)
+	r.Equal(`ALTER SECRET "database"."schema"."secret" AS '<redacted>';`, records[1].Statement)
+	r.Equal(`CREATE ROLE "role" WITH LOGIN PASSWORD '<redacted>';`, records[2].Statement)
+	r.Equal(`ALTER ROLE "role" WITH PASSWORD '<redacted>';`, records[3].Statement)
+}
+
+func TestRedactStatement(t *testing.T) {
+	for statement, expected := range map[string]string{
+		`CREATE VIEW "v" AS SELECT 'value';`:                       `CREATE VIEW "v" AS SELECT 'value';`,
+		`CREATE SECRET IF NOT EXISTS "s" AS 'value';`:              `CREATE SECRET IF NOT EXISTS "s" AS '<redacted>';`,
+		`ALTER SECRET "s" RENAME TO "t";`:                          `ALTER SECRET "s" RENAME TO "t";`,
+		`CREATE CONNECTION "c" TO POSTGRES (PASSWORD SECRET "s");`: `CREATE CONNECTION "c" TO POSTGRES (PASSWORD SECRET "s");`,
+	} {
+		require.Equal(t, expected, redactStatement(statement))
+	}
+}
+
+func TestStatementLogSessionsAreCached(t *testing.T) {
+	r := require.New(t)
+	db, _, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.RecordStatements(&StatementLog{w: &bytes.Buffer{}}, Region("aws/us-east-1"))
+
+	session, err := client.Session(SessionConfig{})
+	r.NoError(err)
+	again, err := client.Session(SessionConfig{})
+	r.NoError(err)
+
+	r.Same(session, again)
+}
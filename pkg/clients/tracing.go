@@ -0,0 +1,148 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/MaterializeInc/terraform-provider-materialize"
+
+// Span attributes naming what the provider was doing.
+const (
+	AttributeResource = attribute.Key("materialize.resource")
+	AttributeObject   = attribute.Key("materialize.object")
+	AttributeRegion   = attribute.Key("materialize.region")
+)
+
+// TracingConfig configures where spans are exported. Spans are only recorded
+// when at least one exporter is set.
+type TracingConfig struct {
+	// Endpoint is the URL of an OTLP/HTTP collector. Without a path, spans
+	// are sent to /v1/traces. The OTEL_EXPORTER_OTLP_HEADERS environment
+	// variable adds headers, such as credentials, to the requests.
+	Endpoint string
+	// File is appended to with a JSON line per span.
+	File string
+}
+
+func (c TracingConfig) IsEmpty() bool {
+	return c.Endpoint == "" && c.File == ""
+}
+
+var (
+	tracingMu      sync.Mutex
+	tracerProvider *sdktrace.TracerProvider
+)
+
+// Tracer returns the tracer spans are started with. Its spans are dropped
+// unless ConfigureTracing was called.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// TracingEnabled reports whether spans are exported.
+func TracingEnabled() bool {
+	tracingMu.Lock()
+	defer tracingMu.Unlock()
+	return tracerProvider != nil
+}
+
+// ConfigureTracing exports spans as configured, replacing the exporters of an
+// earlier call.
+func ConfigureTracing(ctx context.Context, config TracingConfig, version string) error {
+	var options []sdktrace.TracerProviderOption
+
+	if config.File != "" {
+		f, err := os.OpenFile(config.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("unable to open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return fmt.Errorf("unable to create trace file exporter: %w", err)
+		}
+		// Spans are written as they end, the provider may be stopped
+		// before a batch would be flushed.
+		options = append(options, sdktrace.WithSyncer(exporter))
+	}
+
+	if config.Endpoint != "" {
+		endpoint, err := otlpEndpoint(config.Endpoint)
+		if err != nil {
+			return err
+		}
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+		if err != nil {
+			return fmt.Errorf("unable to create OTLP exporter: %w", err)
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	if len(options) == 0 {
+		return nil
+	}
+
+	options = append(options, sdktrace.WithResource(resource.NewSchemaless(
+		attribute.String("service.name", "terraform-provider-materialize"),
+		attribute.String("service.version", version),
+	)))
+	provider := sdktrace.NewTracerProvider(options...)
+
+	tracingMu.Lock()
+	previous := tracerProvider
+	tracerProvider = provider
+	tracingMu.Unlock()
+
+	otel.SetTracerProvider(provider)
+	if previous != nil {
+		return previous.Shutdown(ctx)
+	}
+	return nil
+}
+
+// ShutdownTracing exports the spans that are still buffered. It is called
+// before the provider exits.
+func ShutdownTracing(ctx context.Context) error {
+	tracingMu.Lock()
+	provider := tracerProvider
+	tracerProvider = nil
+	tracingMu.Unlock()
+
+	if provider == nil {
+		return nil
+	}
+	return provider.Shutdown(ctx)
+}
+
+func otlpEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("invalid OTLP endpoint %q, expected a URL such as http://localhost:4318", endpoint)
+	}
+	if strings.Trim(u.Path, "/") == "" {
+		u.Path = "/v1/traces"
+	}
+	return u.String(), nil
+}
+
+// statementOperation names the span of a statement after its first keyword,
+// such as CREATE or SELECT.
+func statementOperation(statement string) string {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return "SQL"
+	}
+	return strings.ToUpper(strings.TrimSuffix(fields[0], ";"))
+}
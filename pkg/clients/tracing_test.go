@@ -0,0 +1,199 @@
+package clients
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func withSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]string {
+	attributes := map[attribute.Key]string{}
+	for _, a := range span.Attributes() {
+		attributes[a.Key] = a.Value.Emit()
+	}
+	return attributes
+}
+
+func TestTracingStatements(t *testing.T) {
+	r := require.New(t)
+	recorder := withSpanRecorder(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.Trace(Region("aws/us-east-1"))
+
+	mock.ExpectExec(`CREATE SCHEMA "database"."schema";`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT id FROM mz_schemas`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("u1"))
+
+	conn, err := client.Session(SessionConfig{})
+	r.NoError(err)
+	ctx := ContextWithResource(context.Background(), ResourceRef{Type: "materialize_schema", Object: `"database"."schema"`})
+	_, err = conn.ExecContext(ctx, `CREATE SCHEMA "database"."schema";`)
+	r.NoError(err)
+	var id string
+	r.NoError(conn.GetContext(ctx, &id, `SELECT id FROM mz_schemas;`))
+	r.NoError(mock.ExpectationsWereMet())
+
+	spans := recorder.Ended()
+	r.Len(spans, 2)
+	r.Equal("CREATE", spans[0].Name())
+	r.Equal(map[attribute.Key]string{
+		"db.system.name":    "materialize",
+		"db.operation.name": "CREATE",
+		"db.query.text":     `CREATE SCHEMA "database"."schema";`,
+		AttributeRegion:     "aws/us-east-1",
+		AttributeResource:   "materialize_schema",
+		AttributeObject:     `"database"."schema"`,
+	}, spanAttributes(spans[0]))
+	r.Equal("SELECT", spans[1].Name())
+}
+
+func TestTracingStatementError(t *testing.T) {
+	r := require.New(t)
+	recorder := withSpanRecorder(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.Trace(Region("aws/us-east-1"))
+
+	mock.ExpectExec(`DROP SCHEMA`).WillReturnError(context.DeadlineExceeded)
+
+	conn, err := client.Session(SessionConfig{})
+	r.NoError(err)
+	_, err = conn.Exec(`DROP SCHEMA "database"."schema";`)
+	r.Error(err)
+
+	spans := recorder.Ended()
+	r.Len(spans, 1)
+	r.Equal(codes.Error, spans[0].Status().Code)
+	r.NotContains(spanAttributes(spans[0]), AttributeResource)
+}
+
+func TestTracingRedactsCredentials(t *testing.T) {
+	r := require.New(t)
+	recorder := withSpanRecorder(t)
+
+	db, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer db.Close()
+
+	client := &DBClient{DB: sqlx.NewDb(db, "sqlmock")}
+	client.Trace(Region("aws/us-east-1"))
+
+	mock.ExpectExec(`CREATE SECRET`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`ALTER ROLE`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	conn, err := client.Session(SessionConfig{})
+	r.NoError(err)
+	_, err = conn.Exec(`CREATE SECRET "database"."schema"."secret" AS 'c2VjcmV0';`)
+	r.NoError(err)
+	_, err = conn.Exec(`ALTER ROLE "role" WITH PASSWORD 'c2VjcmV0';`)
+	r.NoError(err)
+	r.NoError(mock.ExpectationsWereMet())
+
+	spans := recorder.Ended()
+	r.Len(spans, 2)
+	r.Equal(`CREATE SECRET "database"."schema"."secret" AS '<redacted>';`, spanAttributes(spans[0])["db.query.text"])
+	r.Equal(`ALTER ROLE "role" WITH PASSWORD '<redacted>';`, spanAttributes(spans[1])["db.query.text"])
+}
+
+func TestTracingTransport(t *testing.T) {
+	r := require.New(t)
+	recorder := withSpanRecorder(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTracingTransport(nil)}
+	ctx := ContextWithResource(context.Background(), ResourceRef{Type: "materialize_user", Object: "u1", Region: "aws/us-east-1"})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/identity/resources/users/v1?email=joe", nil)
+	r.NoError(err)
+	resp, err := client.Do(req)
+	r.NoError(err)
+	resp.Body.Close()
+
+	spans := recorder.Ended()
+	r.Len(spans, 1)
+	r.Equal("HTTP GET", spans[0].Name())
+	r.Equal(codes.Error, spans[0].Status().Code)
+
+	attributes := spanAttributes(spans[0])
+	r.Equal(server.URL+"/identity/resources/users/v1", attributes["url.full"])
+	r.Equal("404", attributes["http.response.status_code"])
+	r.Equal("materialize_user", attributes[AttributeResource])
+	r.Equal("u1", attributes[AttributeObject])
+	r.Equal("aws/us-east-1", attributes[AttributeRegion])
+}
+
+func TestConfigureTracingFile(t *testing.T) {
+	r := require.New(t)
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	path := filepath.Join(t.TempDir(), "spans.jsonl")
+	r.NoError(ConfigureTracing(context.Background(), TracingConfig{File: path}, "test"))
+	r.True(TracingEnabled())
+
+	_, span := Tracer().Start(context.Background(), "materialize_view create")
+	span.End()
+	r.NoError(ShutdownTracing(context.Background()))
+	r.False(TracingEnabled())
+
+	f, err := os.Open(path)
+	r.NoError(err)
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var s struct{ Name string }
+		r.NoError(json.Unmarshal(scanner.Bytes(), &s))
+		names = append(names, s.Name)
+	}
+	r.Equal([]string{"materialize_view create"}, names)
+}
+
+func TestOtlpEndpoint(t *testing.T) {
+	r := require.New(t)
+
+	endpoint, err := otlpEndpoint("http://localhost:4318")
+	r.NoError(err)
+	r.Equal("http://localhost:4318/v1/traces", endpoint)
+
+	endpoint, err = otlpEndpoint("https://collector.example.com/otlp/v1/traces")
+	r.NoError(err)
+	r.Equal("https://collector.example.com/otlp/v1/traces", endpoint)
+
+	_, err = otlpEndpoint("localhost:4318")
+	r.Error(err)
+}
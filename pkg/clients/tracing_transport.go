@@ -0,0 +1,54 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingTransport starts a span for every request, including its retries.
+type tracingTransport struct {
+	transport http.RoundTripper
+}
+
+// NewTracingTransport wraps transport, http.DefaultTransport if nil, with a span
+// per request. Spans are only exported once tracing is configured.
+func NewTracingTransport(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &tracingTransport{transport: transport}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The query string may carry filters with emails, it is left out
+	u := *req.URL
+	u.RawQuery = ""
+
+	ctx, span := Tracer().Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.full", u.String()),
+			attribute.String("server.address", req.URL.Hostname()),
+		),
+		trace.WithAttributes(resourceAttributes(req.Context())...),
+	)
+	defer span.End()
+
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", resp.StatusCode))
+	}
+	return resp, nil
+}
@@ -59,7 +59,7 @@ func clusterRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListClusters(metaDb)
+	dataSource, err := materialize.ListClusters(ctx, metaDb)
 	if err != nil {
 		return diag.FromErr(err)
 	}
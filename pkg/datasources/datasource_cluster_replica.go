@@ -59,7 +59,7 @@ func clusterReplicaRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListClusterReplicas(metaDb)
+	dataSource, err := materialize.ListClusterReplicas(ctx, metaDb)
 	if err != nil {
 		return diag.FromErr(err)
 	}
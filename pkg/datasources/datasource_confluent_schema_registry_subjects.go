@@ -0,0 +1,209 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var confluentSchemaRegistrySubjectsSchema = map[string]*schema.Schema{
+	"url": {
+		Description: "The URL of the Confluent Schema Registry. Must be reachable from where Terraform runs.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"username": {
+		Description: "The username for the Confluent Schema Registry.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"password": {
+		Description: "The password for the Confluent Schema Registry.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"ssl_certificate_authority": {
+		Description: "The PEM-encoded CA certificate for the Confluent Schema Registry.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"ssl_certificate": {
+		Description:  "The PEM-encoded client certificate for the Confluent Schema Registry.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"ssl_key"},
+	},
+	"ssl_key": {
+		Description:  "The PEM-encoded client key for the Confluent Schema Registry.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		RequiredWith: []string{"ssl_certificate"},
+	},
+	"topic": {
+		Description: "Only return the `<topic>-key` and `<topic>-value` subjects used by the default `TopicNameStrategy`. Both subjects are returned even if nothing has been registered yet, so the compatibility level a sink will be checked against can be inspected before the sink is created.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"global_compatibility_level": {
+		Description: "The registry-wide compatibility level.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"key_compatibility_level": {
+		Description: "The compatibility level that applies to the `<topic>-key` subject. Only set when `topic` is specified.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"value_compatibility_level": {
+		Description: "The compatibility level that applies to the `<topic>-value` subject. Only set when `topic` is specified.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"subjects": {
+		Description: "The subjects in the schema registry.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "The name of the subject.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"versions": {
+					Description: "The registered versions of the subject. Empty if nothing has been registered.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+				},
+				"latest_version": {
+					Description: "The latest registered version of the subject, or `0` if nothing has been registered.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"latest_schema_id": {
+					Description: "The schema ID of the latest registered version, or `0` if nothing has been registered.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"schema_type": {
+					Description: "The type of the latest registered schema: `AVRO`, `PROTOBUF` or `JSON`.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"compatibility_level": {
+					Description: "The compatibility level that applies to the subject, including the global level if the subject has no override.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	},
+}
+
+func ConfluentSchemaRegistrySubjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the subjects, versions and compatibility levels of a Confluent Schema Registry by querying the registry directly. Accepts the same URL and authentication details as `materialize_connection_confluent_schema_registry`, but as plain values rather than secret references.",
+		ReadContext: confluentSchemaRegistrySubjectsRead,
+		Schema:      confluentSchemaRegistrySubjectsSchema,
+	}
+}
+
+func confluentSchemaRegistrySubjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	registryURL := d.Get("url").(string)
+	topic := d.Get("topic").(string)
+
+	client, err := clients.NewSchemaRegistryClient(clients.SchemaRegistryConfig{
+		URL:                     registryURL,
+		Username:                d.Get("username").(string),
+		Password:                d.Get("password").(string),
+		SSLCertificateAuthority: d.Get("ssl_certificate_authority").(string),
+		SSLCertificate:          d.Get("ssl_certificate").(string),
+		SSLKey:                  d.Get("ssl_key").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	global, err := client.GetGlobalCompatibility(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("global_compatibility_level", global); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var subjects []string
+	if topic != "" {
+		key, value := clients.TopicSubjects(topic)
+		subjects = []string{key, value}
+	} else {
+		subjects, err = client.ListSubjects(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		sort.Strings(subjects)
+	}
+
+	subjectMaps := []interface{}{}
+	levels := map[string]string{}
+	for _, subject := range subjects {
+		versions, err := client.ListVersions(ctx, subject)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		level, err := client.GetSubjectCompatibility(ctx, subject)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		levels[subject] = level
+
+		subjectMap := map[string]interface{}{
+			"name":                subject,
+			"versions":            versions,
+			"latest_version":      0,
+			"latest_schema_id":    0,
+			"schema_type":         "",
+			"compatibility_level": level,
+		}
+
+		if len(versions) > 0 {
+			latest, err := client.GetLatestSchema(ctx, subject)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if latest != nil {
+				subjectMap["latest_version"] = latest.Version
+				subjectMap["latest_schema_id"] = latest.ID
+				subjectMap["schema_type"] = latest.SchemaType
+			}
+		}
+
+		subjectMaps = append(subjectMaps, subjectMap)
+	}
+
+	if err := d.Set("subjects", subjectMaps); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if topic != "" {
+		key, value := clients.TopicSubjects(topic)
+		if err := d.Set("key_compatibility_level", levels[key]); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("value_compatibility_level", levels[value]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", registryURL, topic))
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var mockSchemaRegistry = &testhelpers.MockSchemaRegistry{
+	GlobalCompatibility: "BACKWARD",
+	Username:            "user",
+	Password:            "pass",
+	Subjects: map[string]testhelpers.MockSchemaRegistrySubject{
+		"orders-key":   {Versions: []int{1}, SchemaID: 1},
+		"orders-value": {Versions: []int{1, 2, 3}, SchemaID: 4, SchemaType: "PROTOBUF", Compatibility: "FULL_TRANSITIVE"},
+		"users-value":  {Versions: []int{1}, SchemaID: 2},
+	},
+}
+
+func TestConfluentSchemaRegistrySubjectsDatasource(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockSchemaRegistryServer(t, mockSchemaRegistry, func(url string) {
+		in := map[string]interface{}{
+			"url":      url,
+			"username": "user",
+			"password": "pass",
+		}
+		d := schema.TestResourceDataRaw(t, ConfluentSchemaRegistrySubjects().Schema, in)
+		r.NotNil(d)
+
+		if err := confluentSchemaRegistrySubjectsRead(context.TODO(), d, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("BACKWARD", d.Get("global_compatibility_level"))
+		r.Equal("", d.Get("key_compatibility_level"))
+
+		subjects := d.Get("subjects").([]interface{})
+		r.Len(subjects, 3)
+
+		value := subjects[1].(map[string]interface{})
+		r.Equal("orders-value", value["name"])
+		r.Equal([]interface{}{1, 2, 3}, value["versions"])
+		r.Equal(3, value["latest_version"])
+		r.Equal(4, value["latest_schema_id"])
+		r.Equal("PROTOBUF", value["schema_type"])
+		r.Equal("FULL_TRANSITIVE", value["compatibility_level"])
+
+		users := subjects[2].(map[string]interface{})
+		r.Equal("users-value", users["name"])
+		r.Equal("AVRO", users["schema_type"])
+		r.Equal("BACKWARD", users["compatibility_level"])
+	})
+}
+
+func TestConfluentSchemaRegistrySubjectsDatasourceTopic(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockSchemaRegistryServer(t, mockSchemaRegistry, func(url string) {
+		for topic, expected := range map[string][]string{
+			"orders":    {"BACKWARD", "FULL_TRANSITIVE"},
+			"new_topic": {"BACKWARD", "BACKWARD"},
+		} {
+			in := map[string]interface{}{
+				"url":      url,
+				"username": "user",
+				"password": "pass",
+				"topic":    topic,
+			}
+			d := schema.TestResourceDataRaw(t, ConfluentSchemaRegistrySubjects().Schema, in)
+			r.NotNil(d)
+
+			if err := confluentSchemaRegistrySubjectsRead(context.TODO(), d, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			r.Equal(expected[0], d.Get("key_compatibility_level"))
+			r.Equal(expected[1], d.Get("value_compatibility_level"))
+
+			subjects := d.Get("subjects").([]interface{})
+			r.Len(subjects, 2)
+			r.Equal(topic+"-key", subjects[0].(map[string]interface{})["name"])
+			r.Equal(topic+"-value", subjects[1].(map[string]interface{})["name"])
+		}
+	})
+}
+
+func TestConfluentSchemaRegistrySubjectsDatasourceUnauthorized(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockSchemaRegistryServer(t, mockSchemaRegistry, func(url string) {
+		in := map[string]interface{}{"url": url}
+		d := schema.TestResourceDataRaw(t, ConfluentSchemaRegistrySubjects().Schema, in)
+		r.NotNil(d)
+
+		diags := confluentSchemaRegistrySubjectsRead(context.TODO(), d, nil)
+		r.True(diags.HasError())
+	})
+}
@@ -90,14 +90,14 @@ func connectionRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	var tfId string
 
 	if connectionId != "" {
-		connectionData, err = materialize.ScanConnection(metaDb, connectionId)
+		connectionData, err = materialize.ScanConnection(ctx, metaDb, connectionId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 		connections = []materialize.ConnectionParams{connectionData}
 		tfId = fmt.Sprintf("%s|%s", connectionData.ConnectionId.String, "connections")
 	} else {
-		connections, err = materialize.ListConnections(metaDb, schemaName, databaseName)
+		connections, err = materialize.ListConnections(ctx, metaDb, schemaName, databaseName)
 		tfId = "connections"
 		if err != nil {
 			return diag.FromErr(err)
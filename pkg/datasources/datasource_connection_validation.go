@@ -0,0 +1,135 @@
+package datasources
+
+import (
+	"context"
+	"os"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ConnectionValidation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Runs `VALIDATE CONNECTION` against an existing connection each time the data source is read. A failed validation does not fail the plan; it is reported through `valid` and `error` so it can be checked with a precondition or postcondition. The validation changes nothing and also runs in a dry run.",
+		ReadContext: connectionValidationRead,
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Description:  "The ID of the connection to validate. Accepts either the plain ID or the `id` attribute of a connection resource.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"connection_id", "name"},
+			},
+			"name": {
+				Description:  "The name of the connection to validate.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"connection_id", "name"},
+			},
+			"schema_name": {
+				Description:   "The schema of the connection to validate. Defaults to `public` when `name` is set.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"connection_id"},
+			},
+			"database_name": {
+				Description:   "The database of the connection to validate. Defaults to `MZ_DATABASE` environment variable if set or `materialize` if environment variable is not set, when `name` is set.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"connection_id"},
+			},
+			"type": {
+				Description: "The type of the connection.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"valid": {
+				Description: "Whether the connection passed validation.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"error": {
+				Description: "The error returned by Materialize, including any detail and hint, if the connection failed validation. Empty if the connection is valid.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func connectionValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	connectionId := utils.ExtractId(d.Get("connection_id").(string))
+	if name := d.Get("name").(string); name != "" {
+		schemaName := d.Get("schema_name").(string)
+		if schemaName == "" {
+			schemaName = "public"
+		}
+		databaseName := d.Get("database_name").(string)
+		if databaseName == "" {
+			databaseName = os.Getenv("MZ_DATABASE")
+		}
+		if databaseName == "" {
+			databaseName = "materialize"
+		}
+
+		o := materialize.MaterializeObject{Name: name, SchemaName: schemaName, DatabaseName: databaseName}
+		connectionId, err = materialize.ConnectionId(ctx, metaDb, o)
+		if err != nil {
+			return diag.Errorf("unable to find connection %s: %s", o.QualifiedName(), err)
+		}
+		if err := d.Set("connection_id", connectionId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	s, err := materialize.ScanConnection(ctx, metaDb, connectionId)
+	if err != nil {
+		return diag.Errorf("unable to find connection %s: %s", connectionId, err)
+	}
+
+	if err := d.Set("name", s.ConnectionName.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schema_name", s.SchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("database_name", s.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", s.ConnectionType.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	o := materialize.MaterializeObject{
+		Name:         s.ConnectionName.String,
+		SchemaName:   s.SchemaName.String,
+		DatabaseName: s.DatabaseName.String,
+	}
+	validationErr := materialize.NewConnection(ctx, metaDb, o).Validate()
+
+	if err := d.Set("valid", validationErr == nil); err != nil {
+		return diag.FromErr(err)
+	}
+	message := ""
+	if validationErr != nil {
+		message = validationErr.Error()
+	}
+	if err := d.Set("error", message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), s.ConnectionId.String))
+	return nil
+}
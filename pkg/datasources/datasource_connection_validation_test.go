@@ -0,0 +1,105 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionValidationDatasourceByName(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "connection",
+		"schema_name":   "schema",
+		"database_name": "database",
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Id
+		ip := `WHERE mz_connections.name = 'connection' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		mock.ExpectQuery(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnRows(sqlmock.NewRows(nil))
+
+		if err := connectionValidationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+		r.Equal("u1", d.Get("connection_id"))
+		r.Equal("kafka", d.Get("type"))
+		r.Equal(true, d.Get("valid"))
+		r.Equal("", d.Get("error"))
+	})
+}
+
+func TestConnectionValidationDatasourceByIdInvalid(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"connection_id": "aws/us-east-1:u1",
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		mock.ExpectQuery(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnError(fmt.Errorf("failed to connect to broker"))
+
+		if err := connectionValidationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("connection", d.Get("name"))
+		r.Equal(false, d.Get("valid"))
+		r.Equal("failed to connect to broker", d.Get("error"))
+	})
+}
+
+func TestConnectionValidationDatasourceDryRun(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"connection_id": "aws/us-east-1:u1",
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionValidation().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		statementLog, err := clients.NewStatementLog(filepath.Join(t.TempDir(), "statements.jsonl"), true)
+		r.NoError(err)
+		db.DB[clients.AwsUsEast1].RecordStatements(statementLog, clients.AwsUsEast1)
+
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		// The validation still runs in a dry run
+		mock.ExpectQuery(`VALIDATE CONNECTION "database"."schema"."connection";`).WillReturnError(fmt.Errorf("failed to connect to broker"))
+
+		if err := connectionValidationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal(false, d.Get("valid"))
+		r.Equal("failed to connect to broker", d.Get("error"))
+	})
+}
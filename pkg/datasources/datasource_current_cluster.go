@@ -30,7 +30,7 @@ func currentClusterRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	conn := metaDb
 	var name string
-	conn.QueryRow("SHOW CLUSTER;").Scan(&name)
+	conn.QueryRowContext(ctx, "SHOW CLUSTER;").Scan(&name)
 
 	d.Set("name", name)
 	d.SetId(utils.TransformIdWithRegion(string(region), "current_cluster"))
@@ -30,7 +30,7 @@ func currentDatabaseRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	conn := metaDb
 	var name string
-	conn.QueryRow("SHOW DATABASE;").Scan(&name)
+	conn.QueryRowContext(ctx, "SHOW DATABASE;").Scan(&name)
 
 	d.Set("name", name)
 	d.SetId(utils.TransformIdWithRegion(string(region), "current_database"))
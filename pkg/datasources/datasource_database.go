@@ -44,7 +44,7 @@ func databaseRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
-	dataSource, err := materialize.ListDatabases(metaDb)
+	dataSource, err := materialize.ListDatabases(ctx, metaDb)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -38,7 +38,7 @@ func EgressIpsRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	q := materialize.ReadEgressIpsDatasource()
 
-	rows, err := conn.Query(q)
+	rows, err := conn.QueryContext(ctx, q)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		log.Printf("[DEBUG] no egress IPs found in account")
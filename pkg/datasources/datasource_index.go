@@ -69,7 +69,7 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListIndexes(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListIndexes(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -65,7 +65,7 @@ func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListMaterializedViews(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListMaterializedViews(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
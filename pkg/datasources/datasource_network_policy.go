@@ -82,7 +82,7 @@ func networkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	// Add List function to materialize package
-	networkPolicy, err := materialize.ListNetworkPolicies(metaDb)
+	networkPolicy, err := materialize.ListNetworkPolicies(ctx, metaDb)
 	if err != nil {
 		return diag.FromErr(err)
 	}
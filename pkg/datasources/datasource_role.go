@@ -51,7 +51,7 @@ func roleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListRoles(metaDb, likePattern)
+	dataSource, err := materialize.ListRoles(ctx, metaDb, likePattern)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,128 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RoleEffectivePrivileges() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists every privilege a role holds, including privileges inherited through role membership and privileges granted to `PUBLIC`. Object privileges, default privileges and system privileges are combined into a single list.",
+		ReadContext: roleEffectivePrivilegesRead,
+		Schema: map[string]*schema.Schema{
+			"role_name": {
+				Description: "The role to list the effective privileges of.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"include_system_objects": {
+				Description: "Whether to include privileges on system objects, such as the objects in `mz_catalog` that are granted to `PUBLIC`. Defaults to `false`, which only lists privileges on user objects.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"privileges": {
+				Description: "The effective privileges of the role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Description: "Where the privilege comes from: `object` for a privilege on an existing object, `default` for a default privilege applied to new objects, or `system` for a system privilege.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"object_id": {
+							Description: "The ID of the object. Only set for `object` privileges.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"object_type": {
+							Description: "The type of the object, such as `table` or `cluster`. `system` for system privileges.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"object_name": {
+							Description: "The name of the object. Only set for `object` privileges.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schema_name": {
+							Description: "The schema of the object, or the schema a default privilege is limited to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"database_name": {
+							Description: "The database of the object, or the database a default privilege is limited to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"target_role": {
+							Description: "The role whose new objects receive the privilege. Only set for `default` privileges.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"privilege": {
+							Description: "The privilege, such as `SELECT` or `USAGE`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"granted_via_role": {
+							Description: "The role the privilege is granted to. This is the role itself, a role it is a member of, or `PUBLIC`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func roleEffectivePrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+	includeSystemObjects := d.Get("include_system_objects").(bool)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleId, err := materialize.RoleId(ctx, metaDb, roleName)
+	if err != nil {
+		return diag.Errorf("unable to find role %s: %s", roleName, err)
+	}
+
+	privileges, err := materialize.ScanRoleEffectivePrivileges(ctx, metaDb, roleId, includeSystemObjects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privilegeFormats := make([]map[string]interface{}, len(privileges))
+	for i, p := range privileges {
+		privilegeFormats[i] = map[string]interface{}{
+			"kind":             p.Kind,
+			"object_id":        p.ObjectId,
+			"object_type":      p.ObjectType,
+			"object_name":      p.ObjectName,
+			"schema_name":      p.SchemaName,
+			"database_name":    p.DatabaseName,
+			"target_role":      p.TargetRole,
+			"privilege":        p.Privilege,
+			"granted_via_role": p.GrantedViaRole,
+		}
+	}
+
+	if err := d.Set("privileges", privilegeFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), roleId+"|effective_privileges"))
+	return nil
+}
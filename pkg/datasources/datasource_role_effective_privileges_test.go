@@ -0,0 +1,84 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleEffectivePrivilegesDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name": "joe",
+	}
+	d := schema.TestResourceDataRaw(t, RoleEffectivePrivileges().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'joe'`)
+		testhelpers.MockRoleScan(mock, "")
+		testhelpers.MockRoleGrantScan(mock)
+		testhelpers.MockObjectPrivilegeScan(mock, `WHERE objects.id LIKE 'u%'`)
+		testhelpers.MockDefaultPrivilegeScopeScan(mock)
+		testhelpers.MockSystemPrivilege(mock)
+
+		if err := roleEffectivePrivilegesRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1|effective_privileges", d.Id())
+		// Default SELECT and object SELECT granted to joe, object USAGE granted to PUBLIC
+		r.Equal(3, d.Get("privileges.#"))
+		r.Equal("default", d.Get("privileges.0.kind"))
+		r.Equal("joe", d.Get("privileges.0.granted_via_role"))
+		r.Equal("USAGE", d.Get("privileges.1.privilege"))
+		r.Equal("PUBLIC", d.Get("privileges.1.granted_via_role"))
+		r.Equal("table", d.Get("privileges.2.object_name"))
+		r.Equal("SELECT", d.Get("privileges.2.privilege"))
+	})
+}
+
+func TestRoleEffectivePrivilegesDatasourceSystemObjects(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name":              "joe",
+		"include_system_objects": true,
+	}
+	d := schema.TestResourceDataRaw(t, RoleEffectivePrivileges().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'joe'`)
+		testhelpers.MockRoleScan(mock, "")
+		testhelpers.MockRoleGrantScan(mock)
+		testhelpers.MockObjectPrivilegeScan(mock, "")
+		testhelpers.MockDefaultPrivilegeScopeScan(mock)
+		testhelpers.MockSystemPrivilege(mock)
+
+		if err := roleEffectivePrivilegesRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// The SELECT on mz_tables granted to PUBLIC is listed as well
+		r.Equal(4, d.Get("privileges.#"))
+		r.Contains(d.Get("privileges"), map[string]interface{}{
+			"kind":             "object",
+			"object_id":        "s1",
+			"object_type":      "table",
+			"object_name":      "mz_tables",
+			"schema_name":      "mz_catalog",
+			"database_name":    "",
+			"target_role":      "",
+			"privilege":        "SELECT",
+			"granted_via_role": "PUBLIC",
+		})
+	})
+}
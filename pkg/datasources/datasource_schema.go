@@ -55,7 +55,7 @@ func schemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListSchemas(metaDb, databaseName)
+	dataSource, err := materialize.ListSchemas(ctx, metaDb, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -65,7 +65,7 @@ func secretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListSecrets(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListSecrets(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
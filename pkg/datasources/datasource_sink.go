@@ -85,7 +85,7 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListSinks(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListSinks(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
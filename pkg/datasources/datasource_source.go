@@ -85,7 +85,7 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListSources(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListSources(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -88,7 +88,7 @@ func sourceReferenceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	sourceReference, err := materialize.ListSourceReferences(metaDb, sourceID)
+	sourceReference, err := materialize.ListSourceReferences(ctx, metaDb, sourceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -118,7 +118,7 @@ func sourceTableRead(ctx context.Context, d *schema.ResourceData, meta interface
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListSourceTables(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListSourceTables(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,7 +63,7 @@ func systemParameterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if nameExists {
 		query = fmt.Sprintf("SHOW %s;", materialize.QuoteIdentifier(paramName.(string)))
 
-		row := conn.QueryRow(query)
+		row := conn.QueryRowContext(ctx, query)
 		var setting string
 		if err := row.Scan(&setting); err != nil {
 			return diag.FromErr(err)
@@ -79,7 +79,7 @@ func systemParameterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	} else {
 		query = "SHOW ALL;"
 
-		rows, err := conn.Query(query)
+		rows, err := conn.QueryContext(ctx, query)
 		if err != nil {
 			return diag.FromErr(err)
 		}
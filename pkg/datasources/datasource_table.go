@@ -65,7 +65,7 @@ func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListTables(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListTables(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -69,7 +69,7 @@ func typeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListTypes(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListTypes(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,169 @@
+package datasources
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/frontegg"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourceUsersSchema = map[string]*schema.Schema{
+	"email_pattern": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "A regular expression the email address of the users must match.",
+		ValidateFunc: validation.StringIsValidRegExp,
+	},
+	"role_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return users with this organization role, e.g. `Organization Admin`.",
+	},
+	"sso_provisioned": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "If `true`, only return users provisioned through SSO. If `false`, only return users that were invited. If not set, return both.",
+	},
+	"verified": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "If set, only return users whose email address verification status matches.",
+	},
+	"users": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The users matching the filters.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The unique (UUID) identifier of the user.",
+				},
+				"email": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The email address of the user.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the user.",
+				},
+				"verified": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the user's email address has been verified.",
+				},
+				"provider": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The identity provider of the user, `local` for invited users.",
+				},
+				"sso_provisioned": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the user was provisioned through SSO.",
+				},
+				"roles": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The names of the organization roles of the user.",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time the user was created.",
+				},
+			},
+		},
+	},
+}
+
+func Users() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: usersDataSourceRead,
+		Schema:      dataSourceUsersSchema,
+
+		Description: "The users data source allows you to list the users in your Materialize organization.",
+	}
+}
+
+// isSSOProvisioned reports whether the user was created by an identity provider
+// rather than invited to the organization.
+func isSSOProvisioned(u frontegg.UserResponse) bool {
+	return u.Provider != "" && u.Provider != "local"
+}
+
+func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := providerMeta.ValidateSaaSOnly("materialize_users data source"); diags.HasError() {
+		return diags
+	}
+
+	users, err := frontegg.ListUsers(ctx, providerMeta.Frontegg, frontegg.QueryUsersParams{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var emailPattern *regexp.Regexp
+	if v, ok := d.GetOk("email_pattern"); ok {
+		emailPattern = regexp.MustCompile(v.(string))
+	}
+	roleName := d.Get("role_name").(string)
+
+	// false is a valid filter, so unset booleans are told apart with GetOkExists
+	ssoProvisioned, filterSSO := d.GetOkExists("sso_provisioned")
+	verified, filterVerified := d.GetOkExists("verified")
+
+	result := []map[string]interface{}{}
+	for _, u := range users {
+		if emailPattern != nil && !emailPattern.MatchString(u.Email) {
+			continue
+		}
+		if filterSSO && isSSOProvisioned(u) != ssoProvisioned.(bool) {
+			continue
+		}
+		if filterVerified && u.Verified != verified.(bool) {
+			continue
+		}
+
+		roles := []string{}
+		hasRole := roleName == ""
+		for _, role := range u.Roles {
+			roles = append(roles, role.Name)
+			hasRole = hasRole || role.Name == roleName
+		}
+		if !hasRole {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":              u.ID,
+			"email":           u.Email,
+			"name":            u.Name,
+			"verified":        u.Verified,
+			"provider":        u.Provider,
+			"sso_provisioned": isSSOProvisioned(u),
+			"roles":           roles,
+			"created_at":      u.CreatedAt,
+		})
+	}
+
+	if err := d.Set("users", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("users")
+
+	return nil
+}
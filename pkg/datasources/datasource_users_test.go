@@ -0,0 +1,89 @@
+package datasources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func withMockUsersProviderMeta(t *testing.T, f func(providerMeta *utils.ProviderMeta)) {
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		client := &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		f(&utils.ProviderMeta{Frontegg: client})
+	})
+}
+
+func TestUsersDataSourceRead(t *testing.T) {
+	r := require.New(t)
+
+	withMockUsersProviderMeta(t, func(providerMeta *utils.ProviderMeta) {
+		d := schema.TestResourceDataRaw(t, Users().Schema, nil)
+
+		diags := usersDataSourceRead(context.TODO(), d, providerMeta)
+		r.Empty(diags)
+
+		r.Equal("users", d.Id())
+		users := d.Get("users").([]interface{})
+		r.Len(users, 3)
+
+		user := users[1].(map[string]interface{})
+		r.Equal("user-2", user["id"])
+		r.Equal("analyst@example.com", user["email"])
+		r.Equal("Analyst", user["name"])
+		r.Equal(true, user["verified"])
+		r.Equal("saml", user["provider"])
+		r.Equal(true, user["sso_provisioned"])
+		r.Equal([]interface{}{"Organization Member"}, user["roles"])
+		r.Equal("2024-01-02T00:00:00.000Z", user["created_at"])
+	})
+}
+
+func TestUsersDataSourceReadFilters(t *testing.T) {
+	r := require.New(t)
+
+	withMockUsersProviderMeta(t, func(providerMeta *utils.ProviderMeta) {
+		in := map[string]interface{}{
+			"email_pattern": "@example\\.com$",
+			"role_name":     "Organization Member",
+		}
+		d := schema.TestResourceDataRaw(t, Users().Schema, in)
+
+		diags := usersDataSourceRead(context.TODO(), d, providerMeta)
+		r.Empty(diags)
+
+		users := d.Get("users").([]interface{})
+		r.Len(users, 1)
+		r.Equal("analyst@example.com", users[0].(map[string]interface{})["email"])
+	})
+}
+
+func TestUsersDataSourceReadBooleanFilters(t *testing.T) {
+	r := require.New(t)
+
+	withMockUsersProviderMeta(t, func(providerMeta *utils.ProviderMeta) {
+		in := map[string]interface{}{
+			"sso_provisioned": false,
+			"verified":        true,
+		}
+		d := schema.TestResourceDataRaw(t, Users().Schema, in)
+
+		diags := usersDataSourceRead(context.TODO(), d, providerMeta)
+		r.Empty(diags)
+
+		users := d.Get("users").([]interface{})
+		r.Len(users, 1)
+		r.Equal("admin@example.com", users[0].(map[string]interface{})["email"])
+	})
+}
@@ -65,7 +65,7 @@ func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	dataSource, err := materialize.ListViews(metaDb, schemaName, databaseName)
+	dataSource, err := materialize.ListViews(ctx, metaDb, schemaName, databaseName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -47,11 +47,13 @@ type QueryUsersParams struct {
 type UserResponse struct {
 	ID                string     `json:"id"`
 	Email             string     `json:"email"`
+	Name              string     `json:"name"`
 	ProfilePictureURL string     `json:"profilePictureUrl"`
 	Verified          bool       `json:"verified"`
 	Metadata          string     `json:"metadata"`
 	Provider          string     `json:"provider"`
 	Roles             []UserRole `json:"roles"`
+	CreatedAt         string     `json:"createdAt"`
 }
 
 // CreateUser creates a new user in Frontegg.
@@ -107,7 +109,39 @@ func GetUsers(ctx context.Context, client *clients.FronteggClient, params QueryU
 		} `json:"_metadata"`
 	}
 
-	// Construct the query string
+	endpoint := fmt.Sprintf("%s%s?%s", client.Endpoint, UsersApiPathV3, usersQuery(params).Encode())
+
+	resp, err := doRequest(ctx, client, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body failed: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, clients.HandleApiError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decoding response failed: %w", err)
+	}
+
+	if len(response.Items) == 0 {
+		return nil, fmt.Errorf("no user found with email: %s", params.Email)
+	}
+
+	return response.Items, nil
+}
+
+// usersQuery returns the query string of a request for the users matching
+// params. _offset is only sent when params.Offset is set.
+func usersQuery(params QueryUsersParams) url.Values {
 	values := url.Values{}
 	if params.IncludeSubTenants {
 		values.Set("_includeSubTenants", "true")
@@ -130,35 +164,64 @@ func GetUsers(ctx context.Context, client *clients.FronteggClient, params QueryU
 	if params.Order != "" {
 		values.Set("_order", params.Order)
 	}
+	return values
+}
 
-	endpoint := fmt.Sprintf("%s%s?%s", client.Endpoint, UsersApiPathV3, values.Encode())
-
-	resp, err := doRequest(ctx, client, "GET", endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading response body failed: %w", err)
-	}
-
-	resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+// usersPageSize is the number of users requested per page when listing all
+// users of the organization.
+const usersPageSize = 100
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, clients.HandleApiError(resp)
+// ListUsers returns every user matching the params, following the pagination
+// of the users API. The API treats _offset as a page number, not an item offset.
+func ListUsers(ctx context.Context, client *clients.FronteggClient, params QueryUsersParams) ([]UserResponse, error) {
+	if params.Limit <= 0 {
+		params.Limit = usersPageSize
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("decoding response failed: %w", err)
-	}
-
-	if len(response.Items) == 0 {
-		return nil, fmt.Errorf("no user found with email: %s", params.Email)
+	var users []UserResponse
+	for page := 0; ; page++ {
+		var response struct {
+			Items    []UserResponse `json:"items"`
+			Metadata struct {
+				TotalItems int `json:"totalItems"`
+				TotalPages int `json:"totalPages"`
+			} `json:"_metadata"`
+		}
+
+		values := usersQuery(params)
+		values.Set("_offset", fmt.Sprintf("%d", page))
+
+		endpoint := fmt.Sprintf("%s%s?%s", client.Endpoint, UsersApiPathV3, values.Encode())
+		resp, err := doRequest(ctx, client, "GET", endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			return nil, clients.HandleApiError(resp)
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decoding response failed: %w", err)
+		}
+
+		users = append(users, response.Items...)
+
+		if len(response.Items) < params.Limit {
+			break
+		}
+		if response.Metadata.TotalItems > 0 && len(users) >= response.Metadata.TotalItems {
+			break
+		}
+		if response.Metadata.TotalPages > 0 && page+1 >= response.Metadata.TotalPages {
+			break
+		}
 	}
 
-	return response.Items, nil
+	return users, nil
 }
 
 func UpdateUserRoles(ctx context.Context, client *clients.FronteggClient, userID string, email string, roleIDs []string) error {
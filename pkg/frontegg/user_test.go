@@ -253,3 +253,58 @@ func TestUpdateUserRoles(t *testing.T) {
 		t.Fatalf("UpdateUserRoles returned an error: %v", err)
 	}
 }
+
+func TestListUsersPagination(t *testing.T) {
+	var pages []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("_offset")
+		pages = append(pages, page)
+
+		items := []UserResponse{{ID: "user-1"}, {ID: "user-2"}}
+		if page == "1" {
+			items = []UserResponse{{ID: "user-3"}}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"items":     items,
+			"_metadata": map[string]int{"totalItems": 3, "totalPages": 2},
+		})
+	}))
+	defer mockServer.Close()
+
+	client := &clients.FronteggClient{
+		HTTPClient: &http.Client{},
+		Endpoint:   mockServer.URL,
+		Token:      "mock-token",
+	}
+
+	users, err := ListUsers(context.Background(), client, QueryUsersParams{Limit: 2})
+	if err != nil {
+		t.Fatalf("ListUsers returned an error: %v", err)
+	}
+	if len(users) != 3 {
+		t.Fatalf("Expected 3 users, got %d", len(users))
+	}
+	if users[2].ID != "user-3" {
+		t.Errorf("Expected user-3, got %s", users[2].ID)
+	}
+	if strings.Join(pages, ",") != "0,1" {
+		t.Errorf("Expected pages 0,1 to be requested, got %v", pages)
+	}
+}
+
+func TestUsersQuery(t *testing.T) {
+	params := QueryUsersParams{Email: "user@example.com", Limit: 10, Offset: 2, IncludeSubTenants: true}
+
+	got := usersQuery(params).Encode()
+	want := "_email=user%40example.com&_includeSubTenants=true&_limit=10&_offset=2"
+	if got != want {
+		t.Errorf("usersQuery = %q, want %q", got, want)
+	}
+
+	// Without an offset or limit the API applies its defaults
+	got = usersQuery(QueryUsersParams{Email: "user@example.com"}).Encode()
+	want = "_email=user%40example.com"
+	if got != want {
+		t.Errorf("usersQuery = %q, want %q", got, want)
+	}
+}
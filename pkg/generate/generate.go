@@ -0,0 +1,494 @@
+// Package generate emits Terraform import blocks and starter resource
+// configuration for the objects that already exist in a Materialize region.
+package generate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+type Options struct {
+	Region string
+	// Database restricts the databases and Schema the schema-scoped objects
+	// that are generated. Clusters and role memberships are always generated.
+	Database string
+	Schema   string
+	// ImportsOnly skips the resource blocks so the configuration can be
+	// generated by `terraform plan -generate-config-out`.
+	ImportsOnly bool
+}
+
+// attribute is a single argument of a resource block. Value is a string, an
+// int64 or, for nested blocks, a []attribute.
+type attribute struct {
+	Key   string
+	Value interface{}
+}
+
+type block struct {
+	ResourceType string
+	Name         string
+	Id           string
+	Comment      string
+	Attributes   []attribute
+}
+
+var connectionResources = map[string]string{
+	"aws":                       "materialize_connection_aws",
+	"aws-privatelink":           "materialize_connection_aws_privatelink",
+	"confluent-schema-registry": "materialize_connection_confluent_schema_registry",
+	"iceberg-catalog":           "materialize_connection_iceberg_catalog",
+	"kafka":                     "materialize_connection_kafka",
+	"mysql":                     "materialize_connection_mysql",
+	"postgres":                  "materialize_connection_postgres",
+	"sql-server":                "materialize_connection_sqlserver",
+	"ssh-tunnel":                "materialize_connection_ssh_tunnel",
+}
+
+var sourceResources = map[string]string{
+	"kafka":          "materialize_source_kafka",
+	"load-generator": "materialize_source_load_generator",
+	"mysql":          "materialize_source_mysql",
+	"postgres":       "materialize_source_postgres",
+	"sql-server":     "materialize_source_sqlserver",
+	"webhook":        "materialize_source_webhook",
+}
+
+var sinkResources = map[string]string{
+	"iceberg": "materialize_sink_iceberg",
+	"kafka":   "materialize_sink_kafka",
+}
+
+const typeSpecificComment = "Add the type-specific arguments before running terraform plan."
+
+type generator struct {
+	ctx    context.Context
+	w      io.Writer
+	conn   *sqlx.DB
+	opts   Options
+	roles  map[string]string
+	names  map[string]bool
+	blocks []block
+}
+
+// Generate walks the catalog and writes an import block, and unless
+// ImportsOnly is set a starter resource block, for every user-created
+// cluster, database, schema, connection, source, view, materialized view,
+// index, sink, object grant and role membership.
+func Generate(ctx context.Context, w io.Writer, conn *sqlx.DB, opts Options) error {
+	g := &generator{
+		ctx:   ctx,
+		w:     w,
+		conn:  conn,
+		opts:  opts,
+		roles: map[string]string{"p": "PUBLIC"},
+		names: map[string]bool{},
+	}
+
+	roles, err := materialize.ListRoles(ctx, conn, "")
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if isUserObject(r.RoleId.String) {
+			g.roles[r.RoleId.String] = r.RoleName.String
+		}
+	}
+
+	if err := g.clusters(); err != nil {
+		return err
+	}
+
+	databases, err := materialize.ListDatabases(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range databases {
+		if !isUserObject(d.DatabaseId.String) {
+			continue
+		}
+		if opts.Database != "" && d.DatabaseName.String != opts.Database {
+			continue
+		}
+		g.database(d)
+
+		schemas, err := materialize.ListSchemas(ctx, conn, d.DatabaseName.String)
+		if err != nil {
+			return err
+		}
+		for _, s := range schemas {
+			if !isUserObject(s.SchemaId.String) {
+				continue
+			}
+			if opts.Schema != "" && s.SchemaName.String != opts.Schema {
+				continue
+			}
+			if err := g.schema(s); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := g.roleMembers(); err != nil {
+		return err
+	}
+
+	return g.write()
+}
+
+// System objects have ids prefixed with s and are not managed by Terraform.
+func isUserObject(id string) bool {
+	return strings.HasPrefix(id, "u")
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// resourceName converts the parts of a qualified object name into a unique
+// Terraform resource name.
+func (g *generator) resourceName(resourceType string, parts ...string) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.Join(parts, "_"), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	candidate := name
+	for i := 2; g.names[resourceType+"."+candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	g.names[resourceType+"."+candidate] = true
+	return candidate
+}
+
+func (g *generator) add(b block) {
+	g.blocks = append(g.blocks, b)
+}
+
+func (g *generator) id(objectId string) string {
+	return utils.TransformIdWithRegion(g.opts.Region, objectId)
+}
+
+func (g *generator) clusters() error {
+	clusters, err := materialize.ListClusters(g.ctx, g.conn)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range clusters {
+		if !isUserObject(c.ClusterId.String) {
+			continue
+		}
+		name := g.resourceName("materialize_cluster", c.ClusterName.String)
+		attrs := []attribute{{"name", c.ClusterName.String}}
+		if c.Managed.Bool {
+			attrs = append(attrs, attribute{"size", c.Size.String}, attribute{"replication_factor", c.ReplicationFactor.Int64})
+		}
+		g.add(block{
+			ResourceType: "materialize_cluster",
+			Name:         name,
+			Id:           utils.TransformIdWithTypeAndRegion(g.opts.Region, "id", c.ClusterId.String),
+			Attributes:   attrs,
+		})
+		g.grants("CLUSTER", name, c.ClusterId.String, c.OwnerName.String, c.Privileges, []attribute{
+			{"cluster_name", c.ClusterName.String},
+		})
+	}
+	return nil
+}
+
+func (g *generator) database(d materialize.DatabaseParams) {
+	databaseName := d.DatabaseName.String
+
+	name := g.resourceName("materialize_database", databaseName)
+	g.add(block{
+		ResourceType: "materialize_database",
+		Name:         name,
+		Id:           g.id(d.DatabaseId.String),
+		Attributes:   []attribute{{"name", databaseName}},
+	})
+	g.grants("DATABASE", name, d.DatabaseId.String, d.OwnerName.String, d.Privileges, []attribute{
+		{"database_name", databaseName},
+	})
+}
+
+func (g *generator) schema(s materialize.SchemaParams) error {
+	schemaName, databaseName := s.SchemaName.String, s.DatabaseName.String
+
+	name := g.resourceName("materialize_schema", databaseName, schemaName)
+	g.add(block{
+		ResourceType: "materialize_schema",
+		Name:         name,
+		Id:           g.id(s.SchemaId.String),
+		Attributes: []attribute{
+			{"name", schemaName},
+			{"database_name", databaseName},
+		},
+	})
+	g.grants("SCHEMA", name, s.SchemaId.String, s.OwnerName.String, s.Privileges, []attribute{
+		{"schema_name", schemaName},
+		{"database_name", databaseName},
+	})
+
+	qualified := func(key, objectName string) []attribute {
+		return []attribute{
+			{key, objectName},
+			{"schema_name", schemaName},
+			{"database_name", databaseName},
+		}
+	}
+
+	connections, err := materialize.ListConnections(g.ctx, g.conn, schemaName, databaseName)
+	if err != nil {
+		return err
+	}
+	for _, c := range connections {
+		resourceType, ok := connectionResources[c.ConnectionType.String]
+		if !ok || !isUserObject(c.ConnectionId.String) {
+			continue
+		}
+		name := g.resourceName(resourceType, databaseName, schemaName, c.ConnectionName.String)
+		g.add(block{
+			ResourceType: resourceType,
+			Name:         name,
+			Id:           g.id(c.ConnectionId.String),
+			Comment:      typeSpecificComment,
+			Attributes:   qualified("name", c.ConnectionName.String),
+		})
+		g.grants("CONNECTION", name, c.ConnectionId.String, c.OwnerName.String, c.Privileges, qualified("connection_name", c.ConnectionName.String))
+	}
+
+	sources, err := materialize.ListSources(g.ctx, g.conn, schemaName, databaseName)
+	if err != nil {
+		return err
+	}
+	for _, s := range sources {
+		resourceType, ok := sourceResources[s.SourceType.String]
+		if !ok || !isUserObject(s.SourceId.String) {
+			continue
+		}
+		name := g.resourceName(resourceType, databaseName, schemaName, s.SourceName.String)
+		attrs := qualified("name", s.SourceName.String)
+		if s.ClusterName.Valid {
+			attrs = append(attrs, attribute{"cluster_name", s.ClusterName.String})
+		}
+		g.add(block{
+			ResourceType: resourceType,
+			Name:         name,
+			Id:           g.id(s.SourceId.String),
+			Comment:      typeSpecificComment,
+			Attributes:   attrs,
+		})
+		g.grants("SOURCE", name, s.SourceId.String, s.OwnerName.String, s.Privileges, qualified("source_name", s.SourceName.String))
+	}
+
+	views, err := materialize.ListViews(g.ctx, g.conn, schemaName, databaseName)
+	if err != nil {
+		return err
+	}
+	for _, v := range views {
+		if !isUserObject(v.ViewId.String) {
+			continue
+		}
+		name := g.resourceName("materialize_view", databaseName, schemaName, v.ViewName.String)
+		g.add(block{
+			ResourceType: "materialize_view",
+			Name:         name,
+			Id:           g.id(v.ViewId.String),
+			Comment:      "Add the statement before running terraform plan.",
+			Attributes:   qualified("name", v.ViewName.String),
+		})
+		g.grants("VIEW", name, v.ViewId.String, v.OwnerName.String, v.Privileges, qualified("view_name", v.ViewName.String))
+	}
+
+	materializedViews, err := materialize.ListMaterializedViews(g.ctx, g.conn, schemaName, databaseName)
+	if err != nil {
+		return err
+	}
+	for _, v := range materializedViews {
+		if !isUserObject(v.MaterializedViewId.String) {
+			continue
+		}
+		name := g.resourceName("materialize_materialized_view", databaseName, schemaName, v.MaterializedViewName.String)
+		attrs := qualified("name", v.MaterializedViewName.String)
+		if v.Cluster.Valid {
+			attrs = append(attrs, attribute{"cluster_name", v.Cluster.String})
+		}
+		g.add(block{
+			ResourceType: "materialize_materialized_view",
+			Name:         name,
+			Id:           g.id(v.MaterializedViewId.String),
+			Comment:      "Add the statement before running terraform plan.",
+			Attributes:   attrs,
+		})
+		g.grants("MATERIALIZED VIEW", name, v.MaterializedViewId.String, v.OwnerName.String, v.Privileges, qualified("materialized_view_name", v.MaterializedViewName.String))
+	}
+
+	indexes, err := materialize.ListIndexes(g.ctx, g.conn, schemaName, databaseName)
+	if err != nil {
+		return err
+	}
+	for _, i := range indexes {
+		if !isUserObject(i.IndexId.String) {
+			continue
+		}
+		g.add(block{
+			ResourceType: "materialize_index",
+			Name:         g.resourceName("materialize_index", databaseName, schemaName, i.IndexName.String),
+			Id:           g.id(i.IndexId.String),
+			Comment:      "Add the cluster_name and col_expr before running terraform plan.",
+			Attributes: []attribute{
+				{"name", i.IndexName.String},
+				{"obj_name", []attribute{
+					{"name", i.ObjectName.String},
+					{"schema_name", i.ObjectSchemaName.String},
+					{"database_name", i.ObjectDatabaseName.String},
+				}},
+			},
+		})
+	}
+
+	sinks, err := materialize.ListSinks(g.ctx, g.conn, schemaName, databaseName)
+	if err != nil {
+		return err
+	}
+	for _, s := range sinks {
+		resourceType, ok := sinkResources[s.SinkType.String]
+		if !ok || !isUserObject(s.SinkId.String) {
+			continue
+		}
+		attrs := qualified("name", s.SinkName.String)
+		if s.ClusterName.Valid {
+			attrs = append(attrs, attribute{"cluster_name", s.ClusterName.String})
+		}
+		g.add(block{
+			ResourceType: resourceType,
+			Name:         g.resourceName(resourceType, databaseName, schemaName, s.SinkName.String),
+			Id:           g.id(s.SinkId.String),
+			Comment:      typeSpecificComment,
+			Attributes:   attrs,
+		})
+	}
+
+	return nil
+}
+
+// grants adds a grant resource for every privilege held on the object by a
+// user role or PUBLIC. The privileges the owner holds implicitly are skipped.
+func (g *generator) grants(objectType, objectName, objectId, ownerName string, privileges []string, objectAttributes []attribute) {
+	resourceType := fmt.Sprintf("materialize_%s_grant", strings.ToLower(strings.ReplaceAll(objectType, " ", "_")))
+
+	for _, acl := range privileges {
+		item := materialize.ParseMzAclString(acl)
+		roleName, ok := g.roles[item.Grantee]
+		if !ok || roleName == ownerName {
+			continue
+		}
+
+		for _, privilege := range item.Privileges {
+			if privilege == "" {
+				continue
+			}
+			attrs := []attribute{
+				{"role_name", roleName},
+				{"privilege", privilege},
+			}
+			g.add(block{
+				ResourceType: resourceType,
+				Name:         g.resourceName(resourceType, objectName, roleName, privilege),
+				Id:           fmt.Sprintf("%s:GRANT|%s|%s|%s|%s", g.opts.Region, objectType, objectId, item.Grantee, privilege),
+				Attributes:   append(attrs, objectAttributes...),
+			})
+		}
+	}
+}
+
+func (g *generator) roleMembers() error {
+	members, err := materialize.ScanRolePrivilege(g.ctx, g.conn, "", "")
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		roleName, ok := g.roles[m.RoleId.String]
+		if !ok {
+			continue
+		}
+		memberName, ok := g.roles[m.Member.String]
+		if !ok {
+			continue
+		}
+		g.add(block{
+			ResourceType: "materialize_role_grant",
+			Name:         g.resourceName("materialize_role_grant", roleName, memberName),
+			Id:           fmt.Sprintf("%s:ROLE MEMBER|%s|%s", g.opts.Region, m.RoleId.String, m.Member.String),
+			Attributes: []attribute{
+				{"role_name", roleName},
+				{"member_name", memberName},
+			},
+		})
+	}
+	return nil
+}
+
+func (g *generator) write() error {
+	var s strings.Builder
+	for i, b := range g.blocks {
+		if i > 0 {
+			s.WriteString("\n")
+		}
+		fmt.Fprintf(&s, "import {\n  to = %s.%s\n  id = %s\n}\n", b.ResourceType, b.Name, quote(b.Id))
+
+		if g.opts.ImportsOnly {
+			continue
+		}
+
+		s.WriteString("\n")
+		if b.Comment != "" {
+			fmt.Fprintf(&s, "# %s\n", b.Comment)
+		}
+		fmt.Fprintf(&s, "resource %q %q {\n", b.ResourceType, b.Name)
+		writeAttributes(&s, b.Attributes, "  ")
+		s.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(g.w, s.String())
+	return err
+}
+
+// writeAttributes renders the attributes aligned the way terraform fmt does.
+func writeAttributes(s *strings.Builder, attrs []attribute, indent string) {
+	width := 0
+	for _, a := range attrs {
+		if _, ok := a.Value.([]attribute); !ok && len(a.Key) > width {
+			width = len(a.Key)
+		}
+	}
+
+	for _, a := range attrs {
+		switch v := a.Value.(type) {
+		case []attribute:
+			fmt.Fprintf(s, "%s%s {\n", indent, a.Key)
+			writeAttributes(s, v, indent+"  ")
+			fmt.Fprintf(s, "%s}\n", indent)
+		case int64:
+			fmt.Fprintf(s, "%s%-*s = %s\n", indent, width, a.Key, strconv.FormatInt(v, 10))
+		default:
+			fmt.Fprintf(s, "%s%-*s = %s\n", indent, width, a.Key, quote(fmt.Sprint(v)))
+		}
+	}
+}
+
+// quote returns s as an HCL string literal, escaping template sequences.
+func quote(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	return strings.ReplaceAll(q, "%{", "%%{")
+}
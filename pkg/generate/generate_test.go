@@ -0,0 +1,157 @@
+package generate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func mockGenerateScans(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT (.+) FROM mz_roles`).WillReturnRows(
+		mock.NewRows([]string{"id", "role_name"}).
+			AddRow("s1", "mz_system").
+			AddRow("u1", "joe").
+			AddRow("u2", "admin").
+			AddRow("u3", "dev").
+			AddRow("u8", "analyst"),
+	)
+	testhelpers.MockClusterScan(mock, "")
+	testhelpers.MockDatabaseScan(mock, "")
+	testhelpers.MockSchemaScan(mock, "WHERE mz_databases.name = 'database'")
+
+	pp := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+	testhelpers.MockConnectionScan(mock, pp)
+	testhelpers.MockSourceScan(mock, pp)
+	testhelpers.MockViewScan(mock, pp)
+	testhelpers.MockMaterializeViewScan(mock, pp)
+	testhelpers.MockIndexScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`)
+	testhelpers.MockSinkScan(mock, pp)
+	testhelpers.MockRoleGrantScan(mock)
+}
+
+func TestGenerate(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mockGenerateScans(mock)
+
+		var out strings.Builder
+		r.NoError(Generate(context.Background(), &out, db, Options{Region: "aws/us-east-1", Database: "database", Schema: "schema"}))
+		o := out.String()
+
+		r.Contains(o, `import {
+  to = materialize_cluster.cluster
+  id = "aws/us-east-1:id:u1"
+}
+
+resource "materialize_cluster" "cluster" {
+  name               = "cluster"
+  size               = "small"
+  replication_factor = 2
+}
+`)
+		r.Contains(o, `import {
+  to = materialize_database.database
+  id = "aws/us-east-1:u1"
+}
+
+resource "materialize_database" "database" {
+  name = "database"
+}
+`)
+		r.Contains(o, `import {
+  to = materialize_database_grant.database_analyst_SELECT
+  id = "aws/us-east-1:GRANT|DATABASE|u1|u8|SELECT"
+}
+
+resource "materialize_database_grant" "database_analyst_SELECT" {
+  role_name     = "analyst"
+  privilege     = "SELECT"
+  database_name = "database"
+}
+`)
+		r.Contains(o, `import {
+  to = materialize_schema.database_schema
+  id = "aws/us-east-1:u1"
+}
+`)
+		r.Contains(o, `# Add the type-specific arguments before running terraform plan.
+resource "materialize_connection_kafka" "database_schema_connection" {
+  name          = "connection"
+  schema_name   = "schema"
+  database_name = "database"
+}
+`)
+		r.Contains(o, `resource "materialize_source_kafka" "database_schema_source" {`)
+		r.Contains(o, `resource "materialize_view" "database_schema_view" {`)
+		r.Contains(o, `resource "materialize_materialized_view" "database_schema_view" {`)
+		r.Contains(o, `resource "materialize_index" "database_schema_index" {
+  name = "index"
+  obj_name {
+    name          = "obj"
+    schema_name   = "schema"
+    database_name = "database"
+  }
+}
+`)
+		r.Contains(o, `resource "materialize_sink_kafka" "database_schema_sink" {`)
+
+		// Grants to the owner and to system roles are not generated
+		r.Contains(o, `import {
+  to = materialize_view_grant.database_schema_view_analyst_SELECT
+  id = "aws/us-east-1:GRANT|VIEW|u1|u8|SELECT"
+}
+
+resource "materialize_view_grant" "database_schema_view_analyst_SELECT" {
+  role_name     = "analyst"
+  privilege     = "SELECT"
+  view_name     = "view"
+  schema_name   = "schema"
+  database_name = "database"
+}
+`)
+		r.Contains(o, `id = "aws/us-east-1:GRANT|MATERIALIZED VIEW|u1|u8|INSERT"`)
+		r.NotContains(o, `role_name     = "joe"`)
+		r.NotContains(o, "mz_system")
+
+		r.Contains(o, `import {
+  to = materialize_role_grant.admin_dev
+  id = "aws/us-east-1:ROLE MEMBER|u2|u3"
+}
+`)
+	})
+}
+
+func TestGenerateImportsOnly(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mockGenerateScans(mock)
+
+		var out strings.Builder
+		r.NoError(Generate(context.Background(), &out, db, Options{Region: "aws/us-east-1", Database: "database", Schema: "schema", ImportsOnly: true}))
+		o := out.String()
+
+		r.Contains(o, `to = materialize_cluster.cluster`)
+		r.NotContains(o, "resource ")
+	})
+}
+
+func TestResourceName(t *testing.T) {
+	r := require.New(t)
+	g := &generator{names: map[string]bool{}}
+
+	r.Equal("materialize_schema", g.resourceName("materialize_schema", "materialize", "schema"))
+	r.Equal("my_db_my_schema", g.resourceName("materialize_schema", "my-db", "my schema"))
+	r.Equal("_1st", g.resourceName("materialize_cluster", "1st"))
+	r.Equal("materialize_schema_2", g.resourceName("materialize_schema", "materialize", "schema"))
+	r.Equal("materialize_schema", g.resourceName("materialize_view", "materialize", "schema"))
+}
+
+func TestQuote(t *testing.T) {
+	r := require.New(t)
+	r.Equal(`"a \"b\" $${c} %%{d}"`, quote(`a "b" ${c} %{d}`))
+}
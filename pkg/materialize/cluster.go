@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -30,9 +31,9 @@ type ClusterBuilder struct {
 	autoScalingConfig      AutoScalingConfig
 }
 
-func NewClusterBuilder(conn *sqlx.DB, obj MaterializeObject) *ClusterBuilder {
+func NewClusterBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ClusterBuilder {
 	return &ClusterBuilder{
-		ddl:         Builder{conn, Cluster},
+		ddl:         Builder{ctx, conn, Cluster},
 		clusterName: obj.Name,
 	}
 }
@@ -311,6 +312,11 @@ func (b *ClusterBuilder) AlterCluster(reconfig_opts ReconfigurationOptions) erro
 	return b.ddl.exec(q.String())
 }
 
+// Swap atomically exchanges the names of this cluster and another cluster.
+func (b *ClusterBuilder) Swap(otherName string) error {
+	return b.ddl.swap(b.QualifiedName(), QuoteIdentifier(otherName))
+}
+
 func (b *ClusterBuilder) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -381,7 +387,7 @@ type ClusterReconfigParams struct {
 // values. Version-safe: on older Materialize versions without the
 // reconfigurations view (where resizing was synchronous), it reports no
 // in-flight reconfiguration. Any other failure is returned to the caller.
-func ScanClusterPendingReconfiguration(conn *sqlx.DB, clusterId string) (ClusterReconfigParams, bool, error) {
+func ScanClusterPendingReconfiguration(ctx context.Context, conn *sqlx.DB, clusterId string) (ClusterReconfigParams, bool, error) {
 	var p ClusterReconfigParams
 	q := `
 		SELECT
@@ -390,7 +396,7 @@ func ScanClusterPendingReconfiguration(conn *sqlx.DB, clusterId string) (Cluster
 		FROM mz_internal.mz_cluster_reconfigurations
 		WHERE cluster_id = $1 AND status = 'in-progress'`
 
-	if err := conn.Get(&p, q, clusterId); err != nil {
+	if err := conn.GetContext(ctx, &p, q, clusterId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return p, false, nil
 		}
@@ -456,18 +462,18 @@ var clusterQuery = NewBaseQuery(`
 	) comments
 		ON mz_clusters.id = comments.id`)
 
-func ClusterId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func ClusterId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := clusterQuery.QueryPredicate(map[string]string{"mz_clusters.name": obj.Name})
 
 	var c ClusterParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.ClusterId.String, nil
 }
 
-func ScanCluster(conn *sqlx.DB, identifier string, byName bool) (ClusterParams, error) {
+func ScanCluster(ctx context.Context, conn *sqlx.DB, identifier string, byName bool) (ClusterParams, error) {
 	var predicate map[string]string
 	if byName {
 		predicate = map[string]string{"mz_clusters.name": identifier}
@@ -477,7 +483,7 @@ func ScanCluster(conn *sqlx.DB, identifier string, byName bool) (ClusterParams,
 	q := clusterQuery.QueryPredicate(predicate)
 
 	var c ClusterParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -498,7 +504,7 @@ type AutoScalingStrategyParams struct {
 // not exist on older Materialize versions, and clusters without a strategy have
 // no row; both cases return an empty result and no error. Any other failure is
 // returned so a transient error is not mistaken for "no strategy configured".
-func ScanClusterAutoScalingStrategy(conn *sqlx.DB, clusterId string) (AutoScalingStrategyParams, error) {
+func ScanClusterAutoScalingStrategy(ctx context.Context, conn *sqlx.DB, clusterId string) (AutoScalingStrategyParams, error) {
 	var s AutoScalingStrategyParams
 	q := `
 		SELECT
@@ -507,7 +513,7 @@ func ScanClusterAutoScalingStrategy(conn *sqlx.DB, clusterId string) (AutoScalin
 		FROM mz_internal.mz_cluster_auto_scaling_strategies
 		WHERE cluster_id = $1`
 
-	if err := conn.Get(&s, q, clusterId); err != nil {
+	if err := conn.GetContext(ctx, &s, q, clusterId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return AutoScalingStrategyParams{}, nil
 		}
@@ -521,11 +527,11 @@ func ScanClusterAutoScalingStrategy(conn *sqlx.DB, clusterId string) (AutoScalin
 	return s, nil
 }
 
-func ListClusters(conn *sqlx.DB) ([]ClusterParams, error) {
+func ListClusters(ctx context.Context, conn *sqlx.DB) ([]ClusterParams, error) {
 	q := clusterQuery.QueryPredicate(map[string]string{})
 
 	var c []ClusterParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
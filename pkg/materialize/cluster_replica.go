@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -20,9 +21,9 @@ type ClusterReplicaBuilder struct {
 	introspectionDebugging bool
 }
 
-func NewClusterReplicaBuilder(conn *sqlx.DB, obj MaterializeObject) *ClusterReplicaBuilder {
+func NewClusterReplicaBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ClusterReplicaBuilder {
 	return &ClusterReplicaBuilder{
-		ddl:         Builder{conn, ClusterReplica},
+		ddl:         Builder{ctx, conn, ClusterReplica},
 		replicaName: obj.Name,
 		clusterName: obj.ClusterName,
 	}
@@ -132,7 +133,7 @@ var clusterReplicaQuery = NewBaseQuery(`
 	) comments
 		ON mz_cluster_replicas.id = comments.id`)
 
-func ClusterReplicaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func ClusterReplicaId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_cluster_replicas.name": obj.Name,
 		"mz_clusters.name":         obj.ClusterName,
@@ -140,33 +141,33 @@ func ClusterReplicaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := clusterReplicaQuery.QueryPredicate(p)
 
 	var c ClusterReplicaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.ReplicaId.String, nil
 }
 
-func ScanClusterReplica(conn *sqlx.DB, id string) (ClusterReplicaParams, error) {
+func ScanClusterReplica(ctx context.Context, conn *sqlx.DB, id string) (ClusterReplicaParams, error) {
 	p := map[string]string{
 		"mz_cluster_replicas.id": id,
 	}
 	q := clusterReplicaQuery.QueryPredicate(p)
 
 	var c ClusterReplicaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListClusterReplicas(conn *sqlx.DB) ([]ClusterReplicaParams, error) {
+func ListClusterReplicas(ctx context.Context, conn *sqlx.DB) ([]ClusterReplicaParams, error) {
 	p := map[string]string{}
 	q := clusterReplicaQuery.QueryPredicate(p)
 
 	var c []ClusterReplicaParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
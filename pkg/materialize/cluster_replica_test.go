@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestClusterReplicaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "replica", ClusterName: "cluster"}
-		b := NewClusterReplicaBuilder(db, o)
+		b := NewClusterReplicaBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 		b.Disk(true)
 		b.AvailabilityZone("us-east-1")
@@ -33,7 +34,7 @@ func TestClusterReplicaDrop(t *testing.T) {
 		mock.ExpectExec(`DROP CLUSTER REPLICA "cluster"."replica";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "replica", ClusterName: "cluster"}
-		if err := NewClusterReplicaBuilder(db, o).Drop(); err != nil {
+		if err := NewClusterReplicaBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestClusterCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE CLUSTER "cluster" \(REPLICAS \(\)\);`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		if err := NewClusterBuilder(db, o).Create(); err != nil {
+		if err := NewClusterBuilder(context.Background(), db, o).Create(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -29,7 +30,7 @@ func TestClusterManagedCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE CLUSTER "cluster" \(SIZE 'xsmall'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 		if err := b.Create(); err != nil {
 			t.Fatal(err)
@@ -41,7 +42,7 @@ func TestClusterManagedReplicationFactorCreate(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE CLUSTER "cluster" \(SIZE 'xsmall', REPLICATION FACTOR 3\);`).WillReturnResult(sqlmock.NewResult(1, 1))
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 		r := 3
 		b.ReplicationFactor(&r)
@@ -56,7 +57,7 @@ func TestClusterManagedSizeDiskCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE CLUSTER "cluster" \(SIZE 'xsmall', DISK\);`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 		b.Disk(true)
 		if err := b.Create(); err != nil {
@@ -77,7 +78,7 @@ func TestClusterManagedAllCreate(t *testing.T) {
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 		r := 2
 		b.ReplicationFactor(&r)
@@ -95,7 +96,18 @@ func TestClusterDrop(t *testing.T) {
 		mock.ExpectExec(`DROP CLUSTER "cluster";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		if err := NewClusterBuilder(db, o).Drop(); err != nil {
+		if err := NewClusterBuilder(context.Background(), db, o).Drop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClusterSwap(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "blue" SWAP WITH "green";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "blue"}
+		if err := NewClusterBuilder(context.Background(), db, o).Swap("green"); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -107,7 +119,7 @@ func TestClusterWithSchedulingCreate(t *testing.T) {
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 
 		b.Scheduling([]interface{}{
@@ -133,7 +145,7 @@ func TestClusterWithAutoScalingCreate(t *testing.T) {
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 
 		b.AutoScalingStrategy([]interface{}{
@@ -159,7 +171,7 @@ func TestClusterWithAutoScalingNoLingerCreate(t *testing.T) {
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.Size("xsmall")
 
 		b.AutoScalingStrategy([]interface{}{
@@ -184,7 +196,7 @@ func TestClusterAutoScalingUpdate(t *testing.T) {
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.SetAutoScalingStrategy([]interface{}{
 			map[string]interface{}{
 				"on_hydration": []interface{}{
@@ -206,7 +218,7 @@ func TestClusterAutoScalingReset(t *testing.T) {
 		mock.ExpectExec(`ALTER CLUSTER "cluster" RESET \(AUTO SCALING STRATEGY\);`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 
 		if err := b.AlterClusterResetAutoScaling(); err != nil {
 			t.Fatalf("Expected no error, got %v", err)
@@ -220,7 +232,7 @@ func TestClusterUpdate(t *testing.T) {
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.SetSize("xsmall")
 		b.SetReplicationFactor(2)
 
@@ -236,7 +248,7 @@ func TestClusterUpdateWithWaitUntilReady(t *testing.T) {
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "cluster"}
-		b := NewClusterBuilder(db, o)
+		b := NewClusterBuilder(context.Background(), db, o)
 		b.SetSize("xsmall")
 		b.SetReplicationFactor(2)
 
@@ -251,7 +263,7 @@ func TestScanClusterAutoScalingStrategyMissingView(t *testing.T) {
 		mock.ExpectQuery(`FROM mz_internal.mz_cluster_auto_scaling_strategies`).
 			WillReturnError(&pgconn.PgError{Code: "42P01", Message: "unknown catalog item"})
 
-		s, err := ScanClusterAutoScalingStrategy(db, "u1")
+		s, err := ScanClusterAutoScalingStrategy(context.Background(), db, "u1")
 		if err != nil {
 			t.Fatalf("Expected a missing view to degrade gracefully, got %v", err)
 		}
@@ -266,7 +278,7 @@ func TestScanClusterAutoScalingStrategyQueryError(t *testing.T) {
 		mock.ExpectQuery(`FROM mz_internal.mz_cluster_auto_scaling_strategies`).
 			WillReturnError(errors.New("connection reset by peer"))
 
-		if _, err := ScanClusterAutoScalingStrategy(db, "u1"); err == nil {
+		if _, err := ScanClusterAutoScalingStrategy(context.Background(), db, "u1"); err == nil {
 			t.Fatal("Expected a transient failure to be returned, not swallowed")
 		}
 	})
@@ -277,7 +289,7 @@ func TestScanClusterPendingReconfigurationMissingView(t *testing.T) {
 		mock.ExpectQuery(`FROM mz_internal.mz_cluster_reconfigurations`).
 			WillReturnError(&pgconn.PgError{Code: "42P01", Message: "unknown catalog item"})
 
-		_, inFlight, err := ScanClusterPendingReconfiguration(db, "u1")
+		_, inFlight, err := ScanClusterPendingReconfiguration(context.Background(), db, "u1")
 		if err != nil {
 			t.Fatalf("Expected a missing view to degrade gracefully, got %v", err)
 		}
@@ -292,7 +304,7 @@ func TestScanClusterPendingReconfigurationQueryError(t *testing.T) {
 		mock.ExpectQuery(`FROM mz_internal.mz_cluster_reconfigurations`).
 			WillReturnError(errors.New("connection reset by peer"))
 
-		if _, _, err := ScanClusterPendingReconfiguration(db, "u1"); err == nil {
+		if _, _, err := ScanClusterPendingReconfiguration(context.Background(), db, "u1"); err == nil {
 			t.Fatal("Expected a transient failure to be returned, not swallowed")
 		}
 	})
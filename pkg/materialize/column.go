@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/jmoiron/sqlx"
@@ -34,12 +35,12 @@ var tableColumnQuery = NewBaseQuery(`
 		ON mz_columns.id = comments.id
 		AND mz_columns.position = comments.object_sub_id`).Order("mz_columns.position")
 
-func ListTableColumns(conn *sqlx.DB, objectId string) ([]TableColumnParams, error) {
+func ListTableColumns(ctx context.Context, conn *sqlx.DB, objectId string) ([]TableColumnParams, error) {
 	p := map[string]string{"mz_columns.id": objectId}
 	q := tableColumnQuery.QueryPredicate(p)
 
 	var c []TableColumnParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -76,7 +77,7 @@ var indexColumnQuery = NewBaseQuery(`
 		ON mz_index_columns.index_id = mz_indexes.id
 		AND mz_index_columns.on_position = mz_columns.position`).Order("mz_columns.position")
 
-func ListIndexColumns(conn *sqlx.DB, indexId string) ([]IndexColumnParams, error) {
+func ListIndexColumns(ctx context.Context, conn *sqlx.DB, indexId string) ([]IndexColumnParams, error) {
 	p := map[string]string{
 		"mz_indexes.id": indexId,
 	}
@@ -84,7 +85,7 @@ func ListIndexColumns(conn *sqlx.DB, indexId string) ([]IndexColumnParams, error
 
 	// Filter out non-indexed columns
 	var allColumns []IndexColumnParams
-	if err := conn.Select(&allColumns, q); err != nil {
+	if err := conn.SelectContext(ctx, &allColumns, q); err != nil {
 		return allColumns, err
 	}
 
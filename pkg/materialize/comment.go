@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -11,9 +12,9 @@ type CommentBuilder struct {
 	object MaterializeObject
 }
 
-func NewCommentBuilder(conn *sqlx.DB, obj MaterializeObject) *CommentBuilder {
+func NewCommentBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *CommentBuilder {
 	return &CommentBuilder{
-		ddl:    Builder{conn, Cluster},
+		ddl:    Builder{ctx, conn, Cluster},
 		object: obj,
 	}
 }
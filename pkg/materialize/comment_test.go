@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -14,7 +15,7 @@ func TestCommentObject(t *testing.T) {
 
 		o := MaterializeObject{ObjectType: Table, Name: "table", DatabaseName: "database", SchemaName: "schema"}
 		c := "my comment"
-		if err := NewCommentBuilder(db, o).Object(c); err != nil {
+		if err := NewCommentBuilder(context.Background(), db, o).Object(c); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -26,7 +27,7 @@ func TestCommentColumn(t *testing.T) {
 
 		o := MaterializeObject{ObjectType: Table, Name: "table", DatabaseName: "database", SchemaName: "schema"}
 		c := "my comment"
-		if err := NewCommentBuilder(db, o).Column("column", c); err != nil {
+		if err := NewCommentBuilder(context.Background(), db, o).Column("column", c); err != nil {
 			t.Fatal(err)
 		}
 	})
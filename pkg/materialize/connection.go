@@ -1,7 +1,9 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -30,9 +32,9 @@ type Connection struct {
 	DatabaseName   string
 }
 
-func NewConnection(conn *sqlx.DB, obj MaterializeObject) *Connection {
+func NewConnection(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *Connection {
 	return &Connection{
-		ddl:            Builder{conn, BaseConnection},
+		ddl:            Builder{ctx, conn, BaseConnection},
 		ConnectionName: obj.Name,
 		SchemaName:     obj.SchemaName,
 		DatabaseName:   obj.DatabaseName,
@@ -56,6 +58,13 @@ func (b *Connection) Rename(newConnectionName string) error {
 	return b.ddl.rename(b.QualifiedName(), n)
 }
 
+// Validate checks that Materialize can reach the external system with the
+// connection's current configuration. A failed check is returned as an error.
+func (b *Connection) Validate() error {
+	q := fmt.Sprintf(`VALIDATE CONNECTION %s;`, b.QualifiedName())
+	return b.ddl.query(q)
+}
+
 func (b *Connection) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -96,7 +105,7 @@ var connectionQuery = NewBaseQuery(`
 	) comments
 		ON mz_connections.id = comments.id`)
 
-func ConnectionId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func ConnectionId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_connections.name": obj.Name,
 		"mz_databases.name":   obj.DatabaseName,
@@ -105,25 +114,25 @@ func ConnectionId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := connectionQuery.QueryPredicate(p)
 
 	var c ConnectionParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.ConnectionId.String, nil
 }
 
-func ScanConnection(conn *sqlx.DB, id string) (ConnectionParams, error) {
+func ScanConnection(ctx context.Context, conn *sqlx.DB, id string) (ConnectionParams, error) {
 	q := connectionQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListConnections(conn *sqlx.DB, schemaName, databaseName string) ([]ConnectionParams, error) {
+func ListConnections(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]ConnectionParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -131,7 +140,7 @@ func ListConnections(conn *sqlx.DB, schemaName, databaseName string) ([]Connecti
 	q := connectionQuery.QueryPredicate(p)
 
 	var c []ConnectionParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
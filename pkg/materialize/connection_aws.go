@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -20,8 +21,8 @@ type ConnectionAwsBuilder struct {
 	validate              bool
 }
 
-func NewConnectionAwsBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionAwsBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionAwsBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionAwsBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionAwsBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -176,11 +177,11 @@ var connectionAwsQuery = NewBaseQuery(`
 	) comments
 		ON mz_connections.id = comments.id`)
 
-func ScanConnectionAws(conn *sqlx.DB, id string) (ConnectionAwsParams, error) {
+func ScanConnectionAws(ctx context.Context, conn *sqlx.DB, id string) (ConnectionAwsParams, error) {
 	q := connectionAwsQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionAwsParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
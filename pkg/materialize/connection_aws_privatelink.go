@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -15,8 +16,8 @@ type ConnectionAwsPrivatelinkBuilder struct {
 	validate                     bool
 }
 
-func NewConnectionAwsPrivatelinkBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionAwsPrivatelinkBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionAwsPrivatelinkBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionAwsPrivatelinkBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionAwsPrivatelinkBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -94,11 +95,11 @@ var connectionAwsPrivatelinkQuery = NewBaseQuery(`
 	) comments
 		ON mz_connections.id = comments.id`)
 
-func ScanConnectionAwsPrivatelink(conn *sqlx.DB, id string) (ConnectionAwsPrivatelinkParams, error) {
+func ScanConnectionAwsPrivatelink(ctx context.Context, conn *sqlx.DB, id string) (ConnectionAwsPrivatelinkParams, error) {
 	q := connectionAwsPrivatelinkQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionAwsPrivatelinkParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestConnectionAwsPrivatelinkCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "privatelink_conn", SchemaName: "schema", DatabaseName: "database"}
-		b := NewConnectionAwsPrivatelinkBuilder(db, o)
+		b := NewConnectionAwsPrivatelinkBuilder(context.Background(), db, o)
 		b.PrivateLinkServiceName("com.amazonaws.us-east-1.materialize.example")
 		b.PrivateLinkAvailabilityZones([]string{"use1-az1", "use1-az2"})
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -23,7 +24,7 @@ func TestConnectionAwsCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "aws_conn", SchemaName: "schema", DatabaseName: "database"}
-		b := NewConnectionAwsBuilder(db, o)
+		b := NewConnectionAwsBuilder(context.Background(), db, o)
 		b.Endpoint("localhost")
 		b.AwsRegion("us-east-1")
 		b.AccessKeyId(ValueSecretStruct{Text: "foo"})
@@ -47,7 +48,7 @@ func TestScanConnectionAws(t *testing.T) {
 		testhelpers.MockConnectionAwsScan(mock, pp)
 
 		// Test ScanConnectionAws
-		params, err := ScanConnectionAws(db, "u1")
+		params, err := ScanConnectionAws(context.Background(), db, "u1")
 		if err != nil {
 			t.Fatal(err)
 		}
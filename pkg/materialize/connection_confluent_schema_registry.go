@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -20,8 +21,8 @@ type ConnectionConfluentSchemaRegistryBuilder struct {
 	validate                              bool
 }
 
-func NewConnectionConfluentSchemaRegistryBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionConfluentSchemaRegistryBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionConfluentSchemaRegistryBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionConfluentSchemaRegistryBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionConfluentSchemaRegistryBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -16,7 +17,7 @@ func TestConnectionConfluentSchemaRegistryCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."csr_conn" TO CONFLUENT SCHEMA REGISTRY \(URL 'http://localhost:8081', USERNAME = 'user', PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionConfluentSchemaRegistryBuilder(db, connConfluentSchema)
+		b := NewConnectionConfluentSchemaRegistryBuilder(context.Background(), db, connConfluentSchema)
 		b.ConfluentSchemaRegistryUrl("http://localhost:8081")
 		b.ConfluentSchemaRegistryUsername(ValueSecretStruct{Text: "user"})
 		b.ConfluentSchemaRegistryPassword(IdentifierSchemaStruct{SchemaName: "schema", Name: "password", DatabaseName: "database"})
@@ -32,7 +33,7 @@ func TestConnectionConfluentSchemaRegistryUsernameSecretCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."csr_conn" TO CONFLUENT SCHEMA REGISTRY \(URL 'http://localhost:8081', USERNAME = SECRET "database"."schema"."user", PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionConfluentSchemaRegistryBuilder(db, connConfluentSchema)
+		b := NewConnectionConfluentSchemaRegistryBuilder(context.Background(), db, connConfluentSchema)
 		b.ConfluentSchemaRegistryUrl("http://localhost:8081")
 		b.ConfluentSchemaRegistryUsername(ValueSecretStruct{Secret: IdentifierSchemaStruct{SchemaName: "schema", Name: "user", DatabaseName: "database"}})
 		b.ConfluentSchemaRegistryPassword(IdentifierSchemaStruct{SchemaName: "schema", Name: "password", DatabaseName: "database"})
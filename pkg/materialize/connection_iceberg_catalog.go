@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -16,8 +17,8 @@ type ConnectionIcebergCatalogBuilder struct {
 	validate      bool
 }
 
-func NewConnectionIcebergCatalogBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionIcebergCatalogBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionIcebergCatalogBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionIcebergCatalogBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionIcebergCatalogBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
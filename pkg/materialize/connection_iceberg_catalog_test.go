@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestConnectionIcebergCatalogCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "iceberg_conn", SchemaName: "schema", DatabaseName: "database"}
-		b := NewConnectionIcebergCatalogBuilder(db, o)
+		b := NewConnectionIcebergCatalogBuilder(context.Background(), db, o)
 		b.CatalogType("s3tablesrest")
 		b.Url("https://s3tables.us-east-1.amazonaws.com/iceberg")
 		b.Warehouse("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket")
@@ -35,7 +36,7 @@ func TestConnectionIcebergCatalogCreateWithValidation(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "iceberg_conn", SchemaName: "schema", DatabaseName: "database"}
-		b := NewConnectionIcebergCatalogBuilder(db, o)
+		b := NewConnectionIcebergCatalogBuilder(context.Background(), db, o)
 		b.CatalogType("s3tablesrest")
 		b.Url("https://s3tables.us-east-1.amazonaws.com/iceberg")
 		b.Warehouse("arn:aws:s3tables:us-east-1:123456789012:bucket/my-bucket")
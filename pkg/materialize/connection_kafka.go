@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -113,8 +114,8 @@ type ConnectionKafkaBuilder struct {
 	awsConnection                       IdentifierSchemaStruct
 }
 
-func NewConnectionKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionKafkaBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionKafkaBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionKafkaBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionKafkaBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -16,7 +17,7 @@ func TestConnectionKafkaCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092'\), SECURITY PROTOCOL = 'PLAIN', PROGRESS TOPIC 'topic', SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker: "localhost:9092",
@@ -41,7 +42,7 @@ func TestConnectionKafkaDefaultSshTunnelCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092'\), SSH TUNNEL "database"."schema"."ssh_conn"\, PROGRESS TOPIC 'topic', SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker: "localhost:9092",
@@ -66,7 +67,7 @@ func TestConnectionKafkaMultipleBrokersCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092', 'localhost:9093'\), PROGRESS TOPIC 'topic', SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker: "localhost:9092",
@@ -93,7 +94,7 @@ func TestConnectionKafkaSshCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092' USING SSH TUNNEL "database"."schema"."ssh_conn"\), PROGRESS TOPIC 'topic', SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker:    "localhost:9092",
@@ -118,7 +119,7 @@ func TestConnectionKafkaBrokersCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092', 'localhost:9093'\), PROGRESS TOPIC 'topic', SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker: "localhost:9092",
@@ -145,7 +146,7 @@ func TestConnectionKafkaBrokersSshCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092' USING SSH TUNNEL "database"."schema"."ssh_conn", 'localhost:9093' USING SSH TUNNEL "database"."schema"."ssh_conn"\), PROGRESS TOPIC 'topic', SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker:    "localhost:9092",
@@ -174,7 +175,7 @@ func TestConnectionKafkaSslCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092'\), SECURITY PROTOCOL = 'SSL', PROGRESS TOPIC 'topic', SSL CERTIFICATE AUTHORITY = SECRET "database"."schema"."ca", SSL CERTIFICATE = SECRET "database"."schema"."cert", SSL KEY = SECRET "database"."schema"."key"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker: "localhost:9092",
@@ -199,7 +200,7 @@ func TestConnectionKafkaAwsPrivatelinkCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('b-1.hostname-1:9096' USING AWS PRIVATELINK "database"."schema"."privatelink_conn" \(PORT 9001, AVAILABILITY ZONE 'use1-az1'\), 'b-1.hostname-1:9097' USING AWS PRIVATELINK "database"."schema"."privatelink_conn" \(PORT 9002, AVAILABILITY ZONE 'use1-az2'\)\), SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker:                "b-1.hostname-1:9096",
@@ -320,7 +321,7 @@ func TestConnectionKafkaBrokerMatchingRulesCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('lkc-825730.endpoint.cloud:9092' USING AWS PRIVATELINK "database"."schema"."privatelink_conn", MATCHING '\*.use1-az1.\*' USING AWS PRIVATELINK "database"."schema"."privatelink_conn" \(AVAILABILITY ZONE 'use1-az1'\), MATCHING '\*.use1-az4.\*' USING AWS PRIVATELINK "database"."schema"."privatelink_conn" \(AVAILABILITY ZONE 'use1-az4'\)\), SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker:                "lkc-825730.endpoint.cloud:9092",
@@ -356,7 +357,7 @@ func TestConnectionKafkaBrokerMatchingRulesWithPortCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('broker:9092', MATCHING '\*.use1-az1.\*' USING AWS PRIVATELINK "database"."schema"."privatelink_conn" \(PORT 9001, AVAILABILITY ZONE 'use1-az1'\)\)\) WITH \(VALIDATE = false\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{Broker: "broker:9092"},
 		})
@@ -381,7 +382,7 @@ func TestConnectionKafkaAwsPrivateLinkCreate(t *testing.T) {
 		expectedSQL := `CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \( AWS PRIVATELINK "database"."schema"."privatelink_conn" \(PORT 9000\), SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`
 
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		awsPrivateLink := awsPrivateLinkConnection{
 			PrivateLinkConnection: IdentifierSchemaStruct{SchemaName: "schema", Name: "privatelink_conn", DatabaseName: "database"},
 			PrivateLinkPort:       9000,
@@ -408,7 +409,7 @@ func TestConnectionKafkaProgressTopicReplicationFactorCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('localhost:9092'\), SECURITY PROTOCOL = 'PLAIN', PROGRESS TOPIC 'topic', PROGRESS TOPIC REPLICATION FACTOR 3, SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'user', SASL PASSWORD = SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{
 				Broker: "localhost:9092",
@@ -434,7 +435,7 @@ func TestConnectionKafkaAwsIAMAuthCreate(t *testing.T) {
 
 		mock.ExpectExec(expectedSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionKafkaBuilder(db, connKafka)
+		b := NewConnectionKafkaBuilder(context.Background(), db, connKafka)
 		b.KafkaBrokers([]KafkaBroker{
 			{Broker: "broker1:9092"},
 			{Broker: "broker2:9092"},
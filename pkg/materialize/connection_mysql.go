@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -23,8 +24,8 @@ type ConnectionMySQLBuilder struct {
 	validate            bool
 }
 
-func NewConnectionMySQLBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionMySQLBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionMySQLBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionMySQLBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionMySQLBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
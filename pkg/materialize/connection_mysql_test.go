@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -16,7 +17,7 @@ func TestConnectionMySQLCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."mysql_conn" TO MYSQL \(HOST 'mysql_host', PORT 3306, USER 'user', PASSWORD SECRET "database"."schema"."password"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionMySQLBuilder(db, connMySQL)
+		b := NewConnectionMySQLBuilder(context.Background(), db, connMySQL)
 		b.MySQLHost("mysql_host")
 		b.MySQLPort(3306)
 		b.MySQLUser(ValueSecretStruct{Text: "user"})
@@ -35,7 +36,7 @@ func TestConnectionMySQLSshCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."mysql_conn" TO MYSQL \(HOST 'mysql_host', PORT 3306, USER 'user', PASSWORD SECRET "database"."schema"."password", SSH TUNNEL "database"."schema"."ssh_conn"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionMySQLBuilder(db, connMySQL)
+		b := NewConnectionMySQLBuilder(context.Background(), db, connMySQL)
 		b.MySQLHost("mysql_host")
 		b.MySQLPort(3306)
 		b.MySQLUser(ValueSecretStruct{Text: "user"})
@@ -55,7 +56,7 @@ func TestConnectionMySQLSslCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."mysql_conn" TO MYSQL \(HOST 'mysql_host', PORT 3306, USER SECRET "database"."schema"."user", PASSWORD SECRET "database"."schema"."password", SSL MODE 'verify-ca', SSL CERTIFICATE AUTHORITY SECRET "database"."schema"."root", SSL CERTIFICATE SECRET "database"."schema"."cert", SSL KEY SECRET "database"."schema"."key"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionMySQLBuilder(db, connMySQL)
+		b := NewConnectionMySQLBuilder(context.Background(), db, connMySQL)
 		b.MySQLHost("mysql_host")
 		b.MySQLPort(3306)
 		b.MySQLUser(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "schema", DatabaseName: "database"}})
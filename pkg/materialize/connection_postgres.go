@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -24,8 +25,8 @@ type ConnectionPostgresBuilder struct {
 	validate               bool
 }
 
-func NewConnectionPostgresBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionPostgresBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionPostgresBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionPostgresBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionPostgresBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
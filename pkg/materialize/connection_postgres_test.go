@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -16,7 +17,7 @@ func TestConnectionPostgresCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."postgres_conn" TO POSTGRES \(HOST 'postgres_host', PORT 5432, USER 'user', PASSWORD SECRET "database"."schema"."password", DATABASE 'default'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionPostgresBuilder(db, connPostgres)
+		b := NewConnectionPostgresBuilder(context.Background(), db, connPostgres)
 		b.PostgresHost("postgres_host")
 		b.PostgresPort(5432)
 		b.PostgresUser(ValueSecretStruct{Text: "user"})
@@ -36,7 +37,7 @@ func TestConnectionPostgresSshCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."postgres_conn" TO POSTGRES \(HOST 'postgres_host', PORT 5432, USER 'user', PASSWORD SECRET "database"."schema"."password", SSH TUNNEL "database"."schema"."ssh_conn", DATABASE 'default'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionPostgresBuilder(db, connPostgres)
+		b := NewConnectionPostgresBuilder(context.Background(), db, connPostgres)
 		b.PostgresHost("postgres_host")
 		b.PostgresPort(5432)
 		b.PostgresUser(ValueSecretStruct{Text: "user"})
@@ -57,7 +58,7 @@ func TestConnectionPostgresPrivateLinkCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."postgres_conn" TO POSTGRES \(HOST 'postgres_host', PORT 5432, USER 'user', PASSWORD SECRET "database"."schema"."password", AWS PRIVATELINK "database"."schema"."private_link", DATABASE 'default'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionPostgresBuilder(db, connPostgres)
+		b := NewConnectionPostgresBuilder(context.Background(), db, connPostgres)
 		b.PostgresHost("postgres_host")
 		b.PostgresPort(5432)
 		b.PostgresUser(ValueSecretStruct{Text: "user"})
@@ -78,7 +79,7 @@ func TestConnectionPostgresSslCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."postgres_conn" TO POSTGRES \(HOST 'postgres_host', PORT 5432, USER SECRET "database"."schema"."user", PASSWORD SECRET "database"."schema"."password", SSL MODE 'verify-full', SSL CERTIFICATE AUTHORITY SECRET "database"."schema"."root", SSL CERTIFICATE SECRET "database"."schema"."cert", SSL KEY SECRET "database"."schema"."key", DATABASE 'default'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionPostgresBuilder(db, connPostgres)
+		b := NewConnectionPostgresBuilder(context.Background(), db, connPostgres)
 		b.PostgresHost("postgres_host")
 		b.PostgresPort(5432)
 		b.PostgresUser(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "schema", DatabaseName: "database"}})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -22,8 +23,8 @@ type ConnectionSQLServerBuilder struct {
 	validate                         bool
 }
 
-func NewConnectionSQLServerBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionSQLServerBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionSQLServerBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionSQLServerBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionSQLServerBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
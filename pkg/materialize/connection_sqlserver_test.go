@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -16,7 +17,7 @@ func TestConnectionSQLServerCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 1433, USER 'user', PASSWORD SECRET "database"."schema"."password", DATABASE 'testdb'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		b.SQLServerPort(1433)
 		b.SQLServerUser(ValueSecretStruct{Text: "user"})
@@ -36,7 +37,7 @@ func TestConnectionSQLServerSshCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 1433, USER 'user', PASSWORD SECRET "database"."schema"."password", SSH TUNNEL "database"."schema"."ssh_conn", DATABASE 'testdb'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		b.SQLServerPort(1433)
 		b.SQLServerUser(ValueSecretStruct{Text: "user"})
@@ -57,7 +58,7 @@ func TestConnectionSQLServerAWSPrivateLinkCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 1433, USER SECRET "database"."schema"."user", PASSWORD SECRET "database"."schema"."password", AWS PRIVATELINK "database"."schema"."aws_conn", DATABASE 'testdb'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		b.SQLServerPort(1433)
 		b.SQLServerUser(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "schema", DatabaseName: "database"}})
@@ -78,7 +79,7 @@ func TestConnectionSQLServerWithoutValidation(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 1433, USER 'user', PASSWORD SECRET "database"."schema"."password", DATABASE 'testdb'\) WITH \(VALIDATE = false\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		b.SQLServerPort(1433)
 		b.SQLServerUser(ValueSecretStruct{Text: "user"})
@@ -98,7 +99,7 @@ func TestConnectionSQLServerWithSSLCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 1433, USER 'user', PASSWORD SECRET "database"."schema"."password", SSL MODE 'required', SSL CERTIFICATE AUTHORITY '-----BEGIN CERTIFICATE-----', DATABASE 'testdb'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		b.SQLServerPort(1433)
 		b.SQLServerUser(ValueSecretStruct{Text: "user"})
@@ -120,7 +121,7 @@ func TestConnectionSQLServerWithSSLSecretCreate(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 1433, USER 'user', PASSWORD SECRET "database"."schema"."password", SSL MODE 'verify-ca', SSL CERTIFICATE AUTHORITY SECRET "database"."schema"."ssl_ca_secret", DATABASE 'testdb'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		b.SQLServerPort(1433)
 		b.SQLServerUser(ValueSecretStruct{Text: "user"})
@@ -142,7 +143,7 @@ func TestConnectionSQLServerDefaultPort(t *testing.T) {
 			`CREATE CONNECTION "database"."schema"."sqlserver_conn" TO SQL SERVER \(HOST 'sqlserver_host', PORT 0, USER 'user', PASSWORD SECRET "database"."schema"."password", DATABASE 'testdb'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewConnectionSQLServerBuilder(db, connSQLServer)
+		b := NewConnectionSQLServerBuilder(context.Background(), db, connSQLServer)
 		b.SQLServerHost("sqlserver_host")
 		// Default port should be 0 when not set (will be corrected in future iterations)
 		b.SQLServerUser(ValueSecretStruct{Text: "user"})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -15,8 +16,8 @@ type ConnectionSshTunnelBuilder struct {
 	sshPort int
 }
 
-func NewConnectionSshTunnelBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionSshTunnelBuilder {
-	b := Builder{conn, BaseConnection}
+func NewConnectionSshTunnelBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ConnectionSshTunnelBuilder {
+	b := Builder{ctx, conn, BaseConnection}
 	return &ConnectionSshTunnelBuilder{
 		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -84,11 +85,11 @@ var connectionSshTunnelQuery = NewBaseQuery(`
 	) comments
 		ON mz_connections.id = comments.id`)
 
-func ScanConnectionSshTunnel(conn *sqlx.DB, id string) (ConnectionSshTunnelParams, error) {
+func ScanConnectionSshTunnel(ctx context.Context, conn *sqlx.DB, id string) (ConnectionSshTunnelParams, error) {
 	q := connectionSshTunnelQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
 
 	var c ConnectionSshTunnelParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestConnectionSshTunnelCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "ssh_conn", SchemaName: "schema", DatabaseName: "database"}
-		b := NewConnectionSshTunnelBuilder(db, o)
+		b := NewConnectionSshTunnelBuilder(context.Background(), db, o)
 		b.SSHHost("localhost")
 		b.SSHPort(123)
 		b.SSHUser("user")
@@ -0,0 +1,37 @@
+package materialize
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestConnectionValidate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			`VALIDATE CONNECTION "database"."schema"."conn";`,
+		).WillReturnRows(sqlmock.NewRows(nil))
+
+		o := MaterializeObject{Name: "conn", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewConnection(context.Background(), db, o).Validate(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionValidateError(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			`VALIDATE CONNECTION "database"."schema"."conn";`,
+		).WillReturnError(fmt.Errorf("failed to connect"))
+
+		o := MaterializeObject{Name: "conn", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewConnection(context.Background(), db, o).Validate(); err == nil {
+			t.Fatal("expected validation error")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -12,9 +13,9 @@ type DatabaseBuilder struct {
 	databaseName string
 }
 
-func NewDatabaseBuilder(conn *sqlx.DB, obj MaterializeObject) *DatabaseBuilder {
+func NewDatabaseBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *DatabaseBuilder {
 	return &DatabaseBuilder{
-		ddl:          Builder{conn, Database},
+		ddl:          Builder{ctx, conn, Database},
 		databaseName: obj.Name,
 	}
 }
@@ -63,33 +64,33 @@ var databaseQuery = NewBaseQuery(`
 	) comments
 		ON mz_databases.id = comments.id`)
 
-func DatabaseId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func DatabaseId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := databaseQuery.QueryPredicate(map[string]string{"mz_databases.name": obj.Name})
 
 	var c DatabaseParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.DatabaseId.String, nil
 }
 
-func ScanDatabase(conn *sqlx.DB, id string) (DatabaseParams, error) {
+func ScanDatabase(ctx context.Context, conn *sqlx.DB, id string) (DatabaseParams, error) {
 	q := databaseQuery.QueryPredicate(map[string]string{"mz_databases.id": id})
 
 	var c DatabaseParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListDatabases(conn *sqlx.DB) ([]DatabaseParams, error) {
+func ListDatabases(ctx context.Context, conn *sqlx.DB) ([]DatabaseParams, error) {
 	q := databaseQuery.QueryPredicate(map[string]string{})
 
 	var c []DatabaseParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -13,7 +14,7 @@ func TestDatabaseCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE DATABASE "database";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "database"}
-		if err := NewDatabaseBuilder(db, o).Create(); err != nil {
+		if err := NewDatabaseBuilder(context.Background(), db, o).Create(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/jmoiron/sqlx"
@@ -54,7 +55,7 @@ WITH dependencies AS (
 		ON mz_schemas.database_id = mz_databases.id)
 	SELECT * FROM dependencies`)
 
-func ListDependencies(conn *sqlx.DB, objectId, objectType string) ([]DependencyParams, error) {
+func ListDependencies(ctx context.Context, conn *sqlx.DB, objectId, objectType string) ([]DependencyParams, error) {
 	p := map[string]string{
 		"filter_id": objectId,
 	}
@@ -66,7 +67,7 @@ func ListDependencies(conn *sqlx.DB, objectId, objectType string) ([]DependencyP
 	q := dependencyQuery.QueryPredicate(p)
 
 	var d []DependencyParams
-	if err := conn.Select(&d, q); err != nil {
+	if err := conn.SelectContext(ctx, &d, q); err != nil {
 		return d, err
 	}
 
@@ -1,7 +1,7 @@
 package materialize
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -34,7 +34,11 @@ const (
 	NetworkPolicy    EntityType = "NETWORK POLICY"
 )
 
+// Builder runs the statements of an object. Statements are run with ctx, so
+// they are cancelled on the server once the Terraform timeout of the operation
+// expires.
 type Builder struct {
+	ctx    context.Context
 	conn   *sqlx.DB
 	entity EntityType
 }
@@ -44,26 +48,53 @@ func (b *Builder) exec(statement string) error {
 		statement += ";"
 	}
 
-	_, err := b.conn.Exec(statement)
+	_, err := b.conn.ExecContext(b.ctx, statement)
 	if err != nil {
 		log.Printf("[DEBUG] error executing: %s", statement)
-		if pgErr, ok := err.(*pgconn.PgError); ok {
-			msg := fmt.Sprintf("%s: %s", pgErr.Severity, pgErr.Message)
-			if pgErr.Detail != "" {
-				msg += fmt.Sprintf(" DETAIL: %s", pgErr.Detail)
-			}
-			if pgErr.Hint != "" {
-				msg += fmt.Sprintf(" HINT: %s", pgErr.Hint)
-			}
-			msg += fmt.Sprintf(" (SQLSTATE %s)", pgErr.SQLState())
-			return errors.New(msg)
-		}
-		return err
+		return statementError(err)
 	}
 
 	return nil
 }
 
+// query runs a statement that changes nothing, such as VALIDATE CONNECTION,
+// as a query. Queries are not recorded and run even in a dry run.
+func (b *Builder) query(statement string) error {
+	rows, err := b.conn.QueryContext(b.ctx, statement)
+	if err != nil {
+		log.Printf("[DEBUG] error querying: %s", statement)
+		return statementError(err)
+	}
+	return rows.Close()
+}
+
+// statementError formats a Postgres error with its detail, hint and SQLSTATE.
+func statementError(err error) error {
+	if pgErr, ok := err.(*pgconn.PgError); ok {
+		msg := fmt.Sprintf("%s: %s", pgErr.Severity, pgErr.Message)
+		if pgErr.Detail != "" {
+			msg += fmt.Sprintf(" DETAIL: %s", pgErr.Detail)
+		}
+		if pgErr.Hint != "" {
+			msg += fmt.Sprintf(" HINT: %s", pgErr.Hint)
+		}
+		msg += fmt.Sprintf(" (SQLSTATE %s)", pgErr.SQLState())
+		return &execError{msg: msg, err: pgErr}
+	}
+	return err
+}
+
+// execError keeps the Postgres error of a failed statement available to
+// errors.As behind the formatted message.
+type execError struct {
+	msg string
+	err *pgconn.PgError
+}
+
+func (e *execError) Error() string { return e.msg }
+
+func (e *execError) Unwrap() error { return e.err }
+
 func (b *Builder) drop(name string) error {
 	q := fmt.Sprintf(`DROP %s %s;`, b.entity, name)
 	return b.exec(q)
@@ -79,6 +110,11 @@ func (b *Builder) rename(oldName, newName string) error {
 	return b.exec(q)
 }
 
+func (b *Builder) swap(name, otherName string) error {
+	q := fmt.Sprintf(`ALTER %s %s SWAP WITH %s;`, b.entity, name, otherName)
+	return b.exec(q)
+}
+
 func (b *Builder) resize(name, size string) error {
 	q := fmt.Sprintf(`ALTER %s %s SET (SIZE = %s);`, b.entity, name, QuoteString(size))
 	return b.exec(q)
@@ -0,0 +1,41 @@
+package materialize
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderExecTimeout(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE SOURCE "database"."schema"."source"`).WillReturnResult(sqlmock.NewResult(1, 1)).WillDelayFor(time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+		start := time.Now()
+		err := NewSourceLoadgenBuilder(ctx, db, o).LoadGeneratorType("COUNTER").Create()
+		r.ErrorIs(err, sqlmock.ErrCancelled)
+		r.Less(time.Since(start), time.Minute)
+	})
+}
+
+func TestScanTimeout(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`SELECT`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("u1")).WillDelayFor(time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := ScanCluster(ctx, db, "u1", false)
+		r.ErrorIs(err, sqlmock.ErrCancelled)
+	})
+}
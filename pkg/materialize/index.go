@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -33,9 +34,9 @@ type IndexBuilder struct {
 	colExpr      []IndexColumn
 }
 
-func NewIndexBuilder(conn *sqlx.DB, obj MaterializeObject, indexDefault bool, objName IdentifierSchemaStruct) *IndexBuilder {
+func NewIndexBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject, indexDefault bool, objName IdentifierSchemaStruct) *IndexBuilder {
 	return &IndexBuilder{
-		ddl:          Builder{conn, Index},
+		ddl:          Builder{ctx, conn, Index},
 		indexName:    obj.Name,
 		indexDefault: indexDefault,
 		objName:      objName,
@@ -142,29 +143,47 @@ var indexQuery = NewBaseQuery(`
 		ON mz_indexes.id = comments.id`).
 	CustomPredicate([]string{"mz_objects.type IN ('source', 'view', 'materialized-view')"})
 
-func IndexId(conn *sqlx.DB, indexName string) (string, error) {
+func IndexId(ctx context.Context, conn *sqlx.DB, indexName string) (string, error) {
 	q := indexQuery.QueryPredicate(map[string]string{"mz_indexes.name": indexName})
 
 	var c IndexParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.IndexId.String, nil
 }
 
-func ScanIndex(conn *sqlx.DB, id string) (IndexParams, error) {
+// QualifiedIndexId looks up an index by name within the schema and database
+// of the object it is on, which is also the schema the index lives in.
+func QualifiedIndexId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	p := map[string]string{
+		"mz_indexes.name":   obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q := indexQuery.QueryPredicate(p)
+
+	var c IndexParams
+	if err := conn.GetContext(ctx, &c, q); err != nil {
+		return "", err
+	}
+
+	return c.IndexId.String, nil
+}
+
+func ScanIndex(ctx context.Context, conn *sqlx.DB, id string) (IndexParams, error) {
 	q := indexQuery.QueryPredicate(map[string]string{"mz_indexes.id": id})
 
 	var c IndexParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListIndexes(conn *sqlx.DB, schemaName, databaseName string) ([]IndexParams, error) {
+func ListIndexes(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]IndexParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -173,14 +192,14 @@ func ListIndexes(conn *sqlx.DB, schemaName, databaseName string) ([]IndexParams,
 	q := indexQuery.QueryPredicate(p)
 
 	var c []IndexParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func FindDefaultIndexByObject(conn *sqlx.DB, objectName, schemaName, databaseName string) (IndexParams, error) {
+func FindDefaultIndexByObject(ctx context.Context, conn *sqlx.DB, objectName, schemaName, databaseName string) (IndexParams, error) {
 	// Construct the expected default index name pattern
 	defaultIndexPattern := objectName + "_primary_idx"
 
@@ -195,7 +214,7 @@ func FindDefaultIndexByObject(conn *sqlx.DB, objectName, schemaName, databaseNam
 	q := indexQuery.QueryPredicate(p)
 
 	var index IndexParams
-	if err := conn.Get(&index, q); err != nil {
+	if err := conn.GetContext(ctx, &index, q); err != nil {
 		return IndexParams{}, fmt.Errorf("failed to find default index for object %s.%s.%s: %w",
 			databaseName, schemaName, objectName, err)
 	}
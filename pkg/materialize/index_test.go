@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -17,7 +18,7 @@ func TestIndexFieldCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "index"}
-		b := NewIndexBuilder(db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		b := NewIndexBuilder(context.Background(), db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
 		b.ClusterName("cluster")
 		b.ColExpr([]IndexColumn{
 			{Field: "column"},
@@ -36,7 +37,7 @@ func TestIndexFieldLiteralCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "index"}
-		b := NewIndexBuilder(db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		b := NewIndexBuilder(context.Background(), db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
 		b.ClusterName("cluster")
 		b.ColExpr([]IndexColumn{
 			{Field: "upper(guid)"},
@@ -56,7 +57,7 @@ func TestIndexDefaultCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "index"}
-		b := NewIndexBuilder(db, o, true, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		b := NewIndexBuilder(context.Background(), db, o, true, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
 		b.ClusterName("cluster")
 		b.Method("ARRANGEMENT")
 
@@ -71,7 +72,7 @@ func TestIndexDrop(t *testing.T) {
 		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "index"}
-		b := NewIndexBuilder(db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		b := NewIndexBuilder(context.Background(), db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
 		if err := b.Drop(); err != nil {
 			t.Fatal(err)
 		}
@@ -83,7 +84,7 @@ func TestIndexComment(t *testing.T) {
 		mock.ExpectExec(`COMMENT ON INDEX "database"."schema"."index" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "index"}
-		b := NewIndexBuilder(db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		b := NewIndexBuilder(context.Background(), db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
 		if err := b.Comment("comment"); err != nil {
 			t.Fatal(err)
 		}
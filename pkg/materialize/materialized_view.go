@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -18,9 +19,9 @@ type MaterializedViewBuilder struct {
 	selectStmt           string
 }
 
-func NewMaterializedViewBuilder(conn *sqlx.DB, obj MaterializeObject) *MaterializedViewBuilder {
+func NewMaterializedViewBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *MaterializedViewBuilder {
 	return &MaterializedViewBuilder{
-		ddl:                  Builder{conn, MaterializedView},
+		ddl:                  Builder{ctx, conn, MaterializedView},
 		materializedViewName: obj.Name,
 		schemaName:           obj.SchemaName,
 		databaseName:         obj.DatabaseName,
@@ -119,7 +120,7 @@ var materializedViewQuery = NewBaseQuery(`
 	) comments
 		ON mz_materialized_views.id = comments.id`)
 
-func MaterializedViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func MaterializedViewId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_materialized_views.name": obj.Name,
 		"mz_schemas.name":            obj.SchemaName,
@@ -128,28 +129,28 @@ func MaterializedViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := materializedViewQuery.QueryPredicate(p)
 
 	var c MaterializedViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.MaterializedViewId.String, nil
 }
 
-func ScanMaterializedView(conn *sqlx.DB, id string) (MaterializedViewParams, error) {
+func ScanMaterializedView(ctx context.Context, conn *sqlx.DB, id string) (MaterializedViewParams, error) {
 	p := map[string]string{
 		"mz_materialized_views.id": id,
 	}
 	q := materializedViewQuery.QueryPredicate(p)
 
 	var c MaterializedViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListMaterializedViews(conn *sqlx.DB, schemaName, databaseName string) ([]MaterializedViewParams, error) {
+func ListMaterializedViews(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]MaterializedViewParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -157,7 +158,7 @@ func ListMaterializedViews(conn *sqlx.DB, schemaName, databaseName string) ([]Ma
 	q := materializedViewQuery.QueryPredicate(p)
 
 	var c []MaterializedViewParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
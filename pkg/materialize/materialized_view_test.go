@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestMaterializedViewCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
-		b := NewMaterializedViewBuilder(db, o)
+		b := NewMaterializedViewBuilder(context.Background(), db, o)
 		b.ClusterName("cluster")
 		b.NotNullAssertions([]string{"column_1", "column_2"})
 		b.SelectStmt("SELECT 1 FROM t1")
@@ -31,7 +32,7 @@ func TestMaterializedViewDrop(t *testing.T) {
 		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
-		if err := NewMaterializedViewBuilder(db, o).Drop(); err != nil {
+		if err := NewMaterializedViewBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -22,9 +23,9 @@ type NetworkPolicyBuilder struct {
 	rules []NetworkPolicyRule
 }
 
-func NewNetworkPolicyBuilder(conn *sqlx.DB, obj MaterializeObject) *NetworkPolicyBuilder {
+func NewNetworkPolicyBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *NetworkPolicyBuilder {
 	return &NetworkPolicyBuilder{
-		ddl:  Builder{conn, NetworkPolicy},
+		ddl:  Builder{ctx, conn, NetworkPolicy},
 		name: obj.Name,
 	}
 }
@@ -138,28 +139,28 @@ var networkPolicyQuery = NewBaseQuery(`
 	FROM policy
 	LEFT JOIN rules ON policy.id = rules.policy_id`)
 
-func NetworkPolicyId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func NetworkPolicyId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"policy_name": obj.Name,
 	}
 	q := networkPolicyQuery.QueryPredicate(p)
 
 	var result NetworkPolicyQueryResult
-	if err := conn.Get(&result, q); err != nil {
+	if err := conn.GetContext(ctx, &result, q); err != nil {
 		return "", err
 	}
 
 	return result.PolicyId.String, nil
 }
 
-func ScanNetworkPolicy(conn *sqlx.DB, id string) (NetworkPolicyParams, error) {
+func ScanNetworkPolicy(ctx context.Context, conn *sqlx.DB, id string) (NetworkPolicyParams, error) {
 	p := map[string]string{
 		"policy.id": id,
 	}
 	q := networkPolicyQuery.QueryPredicate(p)
 
 	var result NetworkPolicyQueryResult
-	if err := conn.Get(&result, q); err != nil {
+	if err := conn.GetContext(ctx, &result, q); err != nil {
 		return NetworkPolicyParams{}, err
 	}
 
@@ -179,12 +180,12 @@ func ScanNetworkPolicy(conn *sqlx.DB, id string) (NetworkPolicyParams, error) {
 	return policy, nil
 }
 
-func ListNetworkPolicies(conn *sqlx.DB) ([]NetworkPolicyParams, error) {
+func ListNetworkPolicies(ctx context.Context, conn *sqlx.DB) ([]NetworkPolicyParams, error) {
 	var policies []NetworkPolicyParams
 	q := networkPolicyQuery.QueryPredicate(map[string]string{})
 
 	var results []NetworkPolicyQueryResult
-	if err := conn.Select(&results, q); err != nil {
+	if err := conn.SelectContext(ctx, &results, q); err != nil {
 		return policies, err
 	}
 
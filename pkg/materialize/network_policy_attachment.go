@@ -0,0 +1,114 @@
+package materialize
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const networkPolicyParameter = "network_policy"
+
+// NetworkPolicyAttachmentBuilder activates a network policy, either as the
+// system default or for a single role.
+type NetworkPolicyAttachmentBuilder struct {
+	ctx        context.Context
+	conn       *sqlx.DB
+	roleName   string
+	policyName string
+}
+
+func NewNetworkPolicyAttachmentBuilder(ctx context.Context, conn *sqlx.DB, roleName, policyName string) *NetworkPolicyAttachmentBuilder {
+	return &NetworkPolicyAttachmentBuilder{
+		ctx:        ctx,
+		conn:       conn,
+		roleName:   roleName,
+		policyName: policyName,
+	}
+}
+
+func (b *NetworkPolicyAttachmentBuilder) Attach() error {
+	if b.roleName == "" {
+		return NewSystemParameterBuilder(b.ctx, b.conn, networkPolicyParameter, b.policyName).Set()
+	}
+	return NewRoleParameterBuilder(b.ctx, b.conn, b.roleName, networkPolicyParameter, b.policyName).Set()
+}
+
+func (b *NetworkPolicyAttachmentBuilder) Detach() error {
+	if b.roleName == "" {
+		return NewSystemParameterBuilder(b.ctx, b.conn, networkPolicyParameter, "").Reset()
+	}
+	return NewRoleParameterBuilder(b.ctx, b.conn, b.roleName, networkPolicyParameter, "").Reset()
+}
+
+// ScanNetworkPolicyAttachment returns the network policy attached to the role,
+// or the system default when roleName is empty. sql.ErrNoRows is returned if
+// the role has no network policy of its own.
+func ScanNetworkPolicyAttachment(ctx context.Context, conn *sqlx.DB, roleName string) (string, error) {
+	if roleName == "" {
+		return ShowSystemParameter(ctx, conn, networkPolicyParameter)
+	}
+
+	q := fmt.Sprintf(`
+		SELECT mz_role_parameters.parameter_value
+		FROM mz_catalog.mz_role_parameters
+		JOIN mz_roles
+			ON mz_role_parameters.role_id = mz_roles.id
+		WHERE mz_roles.name = %s
+		AND mz_role_parameters.parameter_name = %s;`,
+		QuoteString(roleName), QuoteString(networkPolicyParameter),
+	)
+
+	var v string
+	if err := conn.QueryRowContext(ctx, q).Scan(&v); err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+// SessionClientAddress returns the address Materialize sees for the current
+// session and the name of the session role. The address is empty when it is
+// unknown, for example on versions of Materialize that do not expose it.
+func SessionClientAddress(ctx context.Context, conn *sqlx.DB) (string, string, error) {
+	q := `
+		SELECT mz_sessions.client_ip::text, current_user
+		FROM mz_internal.mz_sessions
+		WHERE mz_sessions.connection_id = pg_backend_pid();`
+
+	var address sql.NullString
+	var user string
+	if err := conn.QueryRowContext(ctx, q).Scan(&address, &user); err != nil {
+		if isUndefinedObject(err) || err == sql.ErrNoRows {
+			log.Printf("[DEBUG] unable to determine the session client address: %s", err)
+			return "", "", nil
+		}
+		return "", "", err
+	}
+	return address.String, user, nil
+}
+
+// NetworkPolicyAllows reports whether any ingress rule admits the address.
+func NetworkPolicyAllows(rules []NetworkPolicyRule, address string) (bool, error) {
+	ip := net.ParseIP(strings.SplitN(address, "/", 2)[0])
+	if ip == nil {
+		return false, fmt.Errorf("invalid client address %s", address)
+	}
+
+	for _, r := range rules {
+		if r.Action != "allow" || r.Direction != "ingress" {
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(r.Address)
+		if err != nil {
+			return false, fmt.Errorf("invalid address %s in rule %s: %s", r.Address, r.Name, err)
+		}
+		if cidr.Contains(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,64 @@
+package materialize
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkPolicyAttachSystem(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM SET "network_policy" TO 'office_policy';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewNetworkPolicyAttachmentBuilder(context.Background(), db, "", "office_policy").Attach(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNetworkPolicyAttachRole(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER ROLE "analyst" SET "network_policy" TO 'office_policy';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewNetworkPolicyAttachmentBuilder(context.Background(), db, "analyst", "office_policy").Attach(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNetworkPolicyDetachRole(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER ROLE "analyst" RESET "network_policy";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewNetworkPolicyAttachmentBuilder(context.Background(), db, "analyst", "").Detach(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNetworkPolicyAllows(t *testing.T) {
+	r := require.New(t)
+	rules := []NetworkPolicyRule{
+		{Name: "new_york", Action: "allow", Direction: "ingress", Address: "1.2.3.4/28"},
+		{Name: "minnesota", Action: "allow", Direction: "ingress", Address: "2.3.4.5/32"},
+	}
+
+	allowed, err := NetworkPolicyAllows(rules, "1.2.3.10/32")
+	r.NoError(err)
+	r.True(allowed)
+
+	allowed, err = NetworkPolicyAllows(rules, "2.3.4.5")
+	r.NoError(err)
+	r.True(allowed)
+
+	allowed, err = NetworkPolicyAllows(rules, "9.9.9.9")
+	r.NoError(err)
+	r.False(allowed)
+
+	_, err = NetworkPolicyAllows(rules, "not-an-ip")
+	r.Error(err)
+}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -17,7 +18,7 @@ func TestNetworkPolicyCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "office_policy"}
-		b := NewNetworkPolicyBuilder(db, o)
+		b := NewNetworkPolicyBuilder(context.Background(), db, o)
 
 		rules := []NetworkPolicyRule{
 			{
@@ -48,7 +49,7 @@ func TestNetworkPolicyCreateNoRules(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "empty_policy"}
-		b := NewNetworkPolicyBuilder(db, o)
+		b := NewNetworkPolicyBuilder(context.Background(), db, o)
 
 		if err := b.Create(); err != nil {
 			t.Fatal(err)
@@ -65,7 +66,7 @@ func TestNetworkPolicyAlter(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "office_policy"}
-		b := NewNetworkPolicyBuilder(db, o)
+		b := NewNetworkPolicyBuilder(context.Background(), db, o)
 
 		rules := []NetworkPolicyRule{
 			{
@@ -96,7 +97,7 @@ func TestNetworkPolicyDrop(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "office_policy"}
-		if err := NewNetworkPolicyBuilder(db, o).Drop(); err != nil {
+		if err := NewNetworkPolicyBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -118,7 +119,7 @@ func TestNetworkPolicyScan(t *testing.T) {
 
 		mock.ExpectQuery(`WITH policy AS`).WillReturnRows(rows)
 
-		policy, err := ScanNetworkPolicy(db, "u1")
+		policy, err := ScanNetworkPolicy(context.Background(), db, "u1")
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1,6 +1,10 @@
 package materialize
 
-import "github.com/jmoiron/sqlx"
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
 
 // Any Materialize Database Object. Will contain name and optionally database and schema
 // Cluster name only applies to cluster replicas
@@ -55,43 +59,43 @@ func (g *MaterializeObject) QualifiedName() string {
 	return QualifiedName(fields...)
 }
 
-func ObjectId(conn *sqlx.DB, object MaterializeObject) (string, error) {
+func ObjectId(ctx context.Context, conn *sqlx.DB, object MaterializeObject) (string, error) {
 	var i string
 	var e error
 
 	switch object.ObjectType {
 	case Database:
-		i, e = DatabaseId(conn, object)
+		i, e = DatabaseId(ctx, conn, object)
 
 	case Schema:
-		i, e = SchemaId(conn, object)
+		i, e = SchemaId(ctx, conn, object)
 
 	case Table:
-		i, e = TableId(conn, object)
+		i, e = TableId(ctx, conn, object)
 
 	case View:
-		i, e = ViewId(conn, object)
+		i, e = ViewId(ctx, conn, object)
 
 	case MaterializedView:
-		i, e = MaterializedViewId(conn, object)
+		i, e = MaterializedViewId(ctx, conn, object)
 
 	case BaseType:
-		i, e = TypeId(conn, object)
+		i, e = TypeId(ctx, conn, object)
 
 	case BaseSource:
-		i, e = SourceId(conn, object)
+		i, e = SourceId(ctx, conn, object)
 
 	case BaseConnection:
-		i, e = ConnectionId(conn, object)
+		i, e = ConnectionId(ctx, conn, object)
 
 	case Secret:
-		i, e = SecretId(conn, object)
+		i, e = SecretId(ctx, conn, object)
 
 	case Cluster:
-		i, e = ClusterId(conn, object)
+		i, e = ClusterId(ctx, conn, object)
 
 	case NetworkPolicy:
-		i, e = NetworkPolicyId(conn, object)
+		i, e = NetworkPolicyId(ctx, conn, object)
 	}
 
 	if e != nil {
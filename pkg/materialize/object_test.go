@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -33,7 +34,7 @@ func TestObjectId(t *testing.T) {
 		ip := `WHERE mz_databases.name = 'materialize'`
 		testhelpers.MockDatabaseScan(mock, ip)
 
-		_, err := ObjectId(db, o)
+		_, err := ObjectId(context.Background(), db, o)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -11,9 +12,9 @@ type OwnershipBuilder struct {
 	object MaterializeObject
 }
 
-func NewOwnershipBuilder(conn *sqlx.DB, object MaterializeObject) *OwnershipBuilder {
+func NewOwnershipBuilder(ctx context.Context, conn *sqlx.DB, object MaterializeObject) *OwnershipBuilder {
 	return &OwnershipBuilder{
-		ddl:    Builder{conn, Ownership},
+		ddl:    Builder{ctx, conn, Ownership},
 		object: object,
 	}
 }
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
@@ -19,7 +20,7 @@ func TestOwnershipAlter(t *testing.T) {
 			SchemaName:   "schema",
 			Name:         "table",
 		}
-		b := NewOwnershipBuilder(db, o)
+		b := NewOwnershipBuilder(context.Background(), db, o)
 
 		if err := b.Alter("my_role"); err != nil {
 			t.Fatal(err)
@@ -39,7 +40,7 @@ func TestOwnershipAlterQuotesRoleName(t *testing.T) {
 			SchemaName:   "schema",
 			Name:         "table",
 		}
-		b := NewOwnershipBuilder(db, o)
+		b := NewOwnershipBuilder(context.Background(), db, o)
 
 		if err := b.Alter(`we"ird`); err != nil {
 			t.Fatal(err)
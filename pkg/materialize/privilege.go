@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -145,9 +146,9 @@ type PrivilegeBuilder struct {
 	object    MaterializeObject
 }
 
-func NewPrivilegeBuilder(conn *sqlx.DB, role, privilege string, obj MaterializeObject) *PrivilegeBuilder {
+func NewPrivilegeBuilder(ctx context.Context, conn *sqlx.DB, role, privilege string, obj MaterializeObject) *PrivilegeBuilder {
 	return &PrivilegeBuilder{
-		ddl:       Builder{conn, Privilege},
+		ddl:       Builder{ctx, conn, Privilege},
 		role:      MaterializeRole{name: role},
 		privilege: privilege,
 		object:    obj,
@@ -180,63 +181,63 @@ func (b *PrivilegeBuilder) GrantKey(region, objectId, roleId, privilege string)
 	return fmt.Sprintf(`%[1]s:GRANT|%[2]s|%[3]s|%[4]s|%[5]s`, region, b.object.ObjectType, objectId, roleId, privilege)
 }
 
-func ScanPrivileges(conn *sqlx.DB, objectType EntityType, objectId string) ([]string, error) {
+func ScanPrivileges(ctx context.Context, conn *sqlx.DB, objectType EntityType, objectId string) ([]string, error) {
 	var p []string
 	var e error
 
 	switch objectType {
 	case Database:
-		params, err := ScanDatabase(conn, objectId)
+		params, err := ScanDatabase(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case Schema:
-		params, err := ScanSchema(conn, objectId, false)
+		params, err := ScanSchema(ctx, conn, objectId, false)
 		p = params.Privileges
 		e = err
 
 	case Table:
-		params, err := ScanTable(conn, objectId)
+		params, err := ScanTable(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case View:
-		params, err := ScanView(conn, objectId)
+		params, err := ScanView(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case MaterializedView:
-		params, err := ScanMaterializedView(conn, objectId)
+		params, err := ScanMaterializedView(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case BaseType:
-		params, err := ScanType(conn, objectId)
+		params, err := ScanType(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case BaseSource:
-		params, err := ScanSource(conn, objectId)
+		params, err := ScanSource(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case BaseConnection:
-		params, err := ScanConnection(conn, objectId)
+		params, err := ScanConnection(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case Secret:
-		params, err := ScanSecret(conn, objectId)
+		params, err := ScanSecret(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 
 	case Cluster:
-		params, err := ScanCluster(conn, objectId, false)
+		params, err := ScanCluster(ctx, conn, objectId, false)
 		p = params.Privileges
 		e = err
 
 	case NetworkPolicy:
-		params, err := ScanNetworkPolicy(conn, objectId)
+		params, err := ScanNetworkPolicy(ctx, conn, objectId)
 		p = params.Privileges
 		e = err
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -18,9 +19,9 @@ type DefaultPrivilegeBuilder struct {
 	databaseName string
 }
 
-func NewDefaultPrivilegeBuilder(conn *sqlx.DB, objectType EntityType, grantee, target, privilege string) *DefaultPrivilegeBuilder {
+func NewDefaultPrivilegeBuilder(ctx context.Context, conn *sqlx.DB, objectType EntityType, grantee, target, privilege string) *DefaultPrivilegeBuilder {
 	return &DefaultPrivilegeBuilder{
-		ddl:         Builder{conn, Privilege},
+		ddl:         Builder{ctx, conn, Privilege},
 		objectType:  objectType,
 		privilege:   privilege,
 		granteeRole: MaterializeRole{name: grantee},
@@ -117,7 +118,7 @@ var defaultPrivilegeQuery = NewBaseQuery(`
 	LEFT JOIN mz_databases
 		ON mz_default_privileges.database_id = mz_databases.id`)
 
-func ScanDefaultPrivilege(conn *sqlx.DB, objectType, granteeId, targetRoleId, databaseId, schemaId string) ([]DefaultPrivilegeParams, error) {
+func ScanDefaultPrivilege(ctx context.Context, conn *sqlx.DB, objectType, granteeId, targetRoleId, databaseId, schemaId string) ([]DefaultPrivilegeParams, error) {
 	p := map[string]string{
 		"mz_default_privileges.object_type": strings.ToLower(objectType),
 		"mz_default_privileges.grantee":     granteeId,
@@ -135,7 +136,7 @@ func ScanDefaultPrivilege(conn *sqlx.DB, objectType, granteeId, targetRoleId, da
 	q := defaultPrivilegeQuery.QueryPredicate(p)
 
 	var c []DefaultPrivilegeParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"reflect"
 	"testing"
@@ -66,7 +67,7 @@ func TestDefaultPrivilegeGrantSimple(t *testing.T) {
 			GRANT SELECT ON TABLES TO "joe";
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewDefaultPrivilegeBuilder(db, "TABLE", "joe", "emily", "SELECT")
+		b := NewDefaultPrivilegeBuilder(context.Background(), db, "TABLE", "joe", "emily", "SELECT")
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
 		}
@@ -81,7 +82,7 @@ func TestDefaultPrivilegeGrantComplex(t *testing.T) {
 			GRANT ALL PRIVILEGES ON TABLES TO "intern_managers";
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewDefaultPrivilegeBuilder(db, "TABLE", "intern_managers", "interns", "ALL PRIVILEGES")
+		b := NewDefaultPrivilegeBuilder(context.Background(), db, "TABLE", "intern_managers", "interns", "ALL PRIVILEGES")
 		b.DatabaseName("dev")
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
@@ -96,7 +97,7 @@ func TestDefaultPrivilegeRevokeSimple(t *testing.T) {
 			REVOKE USAGE ON SECRETS FROM "project_managers";
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewDefaultPrivilegeBuilder(db, "SECRET", "project_managers", "developers", "USAGE")
+		b := NewDefaultPrivilegeBuilder(context.Background(), db, "SECRET", "project_managers", "developers", "USAGE")
 		if err := b.Revoke(); err != nil {
 			t.Fatal(err)
 		}
@@ -110,7 +111,7 @@ func TestDefaultPrivilegeGrantPublicTarget(t *testing.T) {
 			GRANT SELECT ON TABLES TO "managers";
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewDefaultPrivilegeBuilder(db, "TABLE", "managers", "PUBLIC", "SELECT")
+		b := NewDefaultPrivilegeBuilder(context.Background(), db, "TABLE", "managers", "PUBLIC", "SELECT")
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
 		}
@@ -124,7 +125,7 @@ func TestDefaultPrivilegeGrantPublicGrantee(t *testing.T) {
 			GRANT SELECT ON TABLES TO PUBLIC;
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewDefaultPrivilegeBuilder(db, "TABLE", "PUBLIC", "managers", "SELECT")
+		b := NewDefaultPrivilegeBuilder(context.Background(), db, "TABLE", "PUBLIC", "managers", "SELECT")
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
 		}
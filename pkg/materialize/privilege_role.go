@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -13,9 +14,9 @@ type RolePrivilegeBuilder struct {
 	member MaterializeRole
 }
 
-func NewRolePrivilegeBuilder(conn *sqlx.DB, role, member string) *RolePrivilegeBuilder {
+func NewRolePrivilegeBuilder(ctx context.Context, conn *sqlx.DB, role, member string) *RolePrivilegeBuilder {
 	return &RolePrivilegeBuilder{
-		ddl:    Builder{conn, Privilege},
+		ddl:    Builder{ctx, conn, Privilege},
 		role:   MaterializeRole{name: role},
 		member: MaterializeRole{name: member},
 	}
@@ -36,9 +37,10 @@ func (b *RolePrivilegeBuilder) GrantKey(region, roleId, memberId string) string
 }
 
 type RolePrivilegeParams struct {
-	RoleId  sql.NullString `db:"role_id"`
-	Member  sql.NullString `db:"member"`
-	Grantor sql.NullString `db:"grantor"`
+	RoleId     sql.NullString `db:"role_id"`
+	Member     sql.NullString `db:"member"`
+	Grantor    sql.NullString `db:"grantor"`
+	MemberName sql.NullString `db:"member_name"`
 }
 
 var rolePrivilegeQuery = NewBaseQuery(`
@@ -48,7 +50,7 @@ var rolePrivilegeQuery = NewBaseQuery(`
 		mz_role_members.grantor
 	FROM mz_role_members`)
 
-func ScanRolePrivilege(conn *sqlx.DB, roleId, memberId string) ([]RolePrivilegeParams, error) {
+func ScanRolePrivilege(ctx context.Context, conn *sqlx.DB, roleId, memberId string) ([]RolePrivilegeParams, error) {
 	p := map[string]string{
 		"mz_role_members.role_id": roleId,
 		"mz_role_members.member":  memberId,
@@ -57,7 +59,30 @@ func ScanRolePrivilege(conn *sqlx.DB, roleId, memberId string) ([]RolePrivilegeP
 	q := rolePrivilegeQuery.QueryPredicate(p)
 
 	var c []RolePrivilegeParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+var roleMembersQuery = NewBaseQuery(`
+	SELECT
+		mz_role_members.role_id,
+		mz_role_members.member,
+		mz_role_members.grantor,
+		members.name AS member_name
+	FROM mz_role_members
+	JOIN mz_roles AS members
+		ON mz_role_members.member = members.id`)
+
+// ListRoleMembers returns the direct members of a role along with their names.
+func ListRoleMembers(ctx context.Context, conn *sqlx.DB, roleId string) ([]RolePrivilegeParams, error) {
+	p := map[string]string{"mz_role_members.role_id": roleId}
+	q := roleMembersQuery.QueryPredicate(p)
+
+	var c []RolePrivilegeParams
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"reflect"
 	"testing"
@@ -45,7 +46,7 @@ func TestRolePrivilegeGrant(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT "dev_role" TO "user";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewRolePrivilegeBuilder(db, "dev_role", "user")
+		b := NewRolePrivilegeBuilder(context.Background(), db, "dev_role", "user")
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
 		}
@@ -56,9 +57,23 @@ func TestRolePrivilegeRevoke(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE "dev_role" FROM "user";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewRolePrivilegeBuilder(db, "dev_role", "user")
+		b := NewRolePrivilegeBuilder(context.Background(), db, "dev_role", "user")
 		if err := b.Revoke(); err != nil {
 			t.Fatal(err)
 		}
 	})
 }
+
+func TestListRoleMembers(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleMembersScan(mock, `WHERE mz_role_members.role_id = 'u1'`, "alice", "bob")
+
+		members, err := ListRoleMembers(context.Background(), db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(members) != 2 || members[0].MemberName.String != "alice" || members[1].MemberName.String != "bob" {
+			t.Fatalf("unexpected members %v", members)
+		}
+	})
+}
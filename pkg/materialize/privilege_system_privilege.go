@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -12,9 +13,9 @@ type SystemPrivilegeBuilder struct {
 	privilege string
 }
 
-func NewSystemPrivilegeBuilder(conn *sqlx.DB, role, privilege string) *SystemPrivilegeBuilder {
+func NewSystemPrivilegeBuilder(ctx context.Context, conn *sqlx.DB, role, privilege string) *SystemPrivilegeBuilder {
 	return &SystemPrivilegeBuilder{
-		ddl:       Builder{conn, Privilege},
+		ddl:       Builder{ctx, conn, Privilege},
 		role:      MaterializeRole{name: role},
 		privilege: privilege,
 	}
@@ -40,9 +41,9 @@ type SytemPrivilegeParams struct {
 
 var systemPrivilegeQuery = `SELECT privileges FROM mz_system_privileges`
 
-func ScanSystemPrivileges(conn *sqlx.DB) ([]SytemPrivilegeParams, error) {
+func ScanSystemPrivileges(ctx context.Context, conn *sqlx.DB) ([]SytemPrivilegeParams, error) {
 	var c []SytemPrivilegeParams
-	if err := conn.Select(&c, systemPrivilegeQuery); err != nil {
+	if err := conn.SelectContext(ctx, &c, systemPrivilegeQuery); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -12,7 +13,7 @@ func TestSystemPrivilegeGrant(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT CREATEDB ON SYSTEM TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSystemPrivilegeBuilder(db, "joe", "CREATEDB")
+		b := NewSystemPrivilegeBuilder(context.Background(), db, "joe", "CREATEDB")
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
 		}
@@ -23,7 +24,7 @@ func TestSystemPrivilegeRevoke(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE CREATEDB ON SYSTEM FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSystemPrivilegeBuilder(db, "joe", "CREATEDB")
+		b := NewSystemPrivilegeBuilder(context.Background(), db, "joe", "CREATEDB")
 		if err := b.Revoke(); err != nil {
 			t.Fatal(err)
 		}
@@ -34,7 +35,7 @@ func TestScanSystemPrivileges(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		testhelpers.MockSystemPrivilege(mock)
 
-		p, err := ScanSystemPrivileges(db)
+		p, err := ScanSystemPrivileges(context.Background(), db)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -103,7 +104,7 @@ func TestPrivilegeGrant(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT CREATE ON DATABASE "materialize" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewPrivilegeBuilder(db, "joe", "CREATE", MaterializeObject{ObjectType: Database, Name: "materialize"})
+		b := NewPrivilegeBuilder(context.Background(), db, "joe", "CREATE", MaterializeObject{ObjectType: Database, Name: "materialize"})
 		if err := b.Grant(); err != nil {
 			t.Fatal(err)
 		}
@@ -114,7 +115,7 @@ func TestPrivilegeRevoke(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE CREATE ON DATABASE "materialize" FROM "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewPrivilegeBuilder(db, "joe", "CREATE", MaterializeObject{ObjectType: Database, Name: "materialize"})
+		b := NewPrivilegeBuilder(context.Background(), db, "joe", "CREATE", MaterializeObject{ObjectType: Database, Name: "materialize"})
 		if err := b.Revoke(); err != nil {
 			t.Fatal(err)
 		}
@@ -127,7 +128,7 @@ func TestScanPrivileges(t *testing.T) {
 		ip := `WHERE mz_databases.id = 'u1'`
 		testhelpers.MockDatabaseScan(mock, ip)
 
-		o, err := ScanPrivileges(db, Database, "u1")
+		o, err := ScanPrivileges(context.Background(), db, Database, "u1")
 		if err != nil {
 			t.Fatal(err)
 		}
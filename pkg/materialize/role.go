@@ -1,8 +1,11 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -17,9 +20,9 @@ type RoleBuilder struct {
 	login     bool
 }
 
-func NewRoleBuilder(conn *sqlx.DB, obj MaterializeObject) *RoleBuilder {
+func NewRoleBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *RoleBuilder {
 	return &RoleBuilder{
-		ddl:      Builder{conn, Role},
+		ddl:      Builder{ctx, conn, Role},
 		roleName: obj.Name,
 	}
 }
@@ -146,7 +149,7 @@ var roleQuery = NewBaseQuery(`
 	) comments
 		ON mz_roles.id = comments.id`)
 
-func RoleId(conn *sqlx.DB, roleName string) (string, error) {
+func RoleId(ctx context.Context, conn *sqlx.DB, roleName string) (string, error) {
 	if roleName == "PUBLIC" {
 		return "p", nil
 	} else {
@@ -154,7 +157,7 @@ func RoleId(conn *sqlx.DB, roleName string) (string, error) {
 		q := roleQuery.QueryPredicate(p)
 
 		var c RoleParams
-		if err := conn.Get(&c, q); err != nil {
+		if err := conn.GetContext(ctx, &c, q); err != nil {
 			return "", err
 		}
 
@@ -162,19 +165,19 @@ func RoleId(conn *sqlx.DB, roleName string) (string, error) {
 	}
 }
 
-func ScanRole(conn *sqlx.DB, id string) (RoleParams, error) {
+func ScanRole(ctx context.Context, conn *sqlx.DB, id string) (RoleParams, error) {
 	p := map[string]string{"mz_roles.id": id}
 	q := roleQuery.QueryPredicate(p)
 
 	var c RoleParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListRoles(conn *sqlx.DB, likePattern string) ([]RoleParams, error) {
+func ListRoles(ctx context.Context, conn *sqlx.DB, likePattern string) ([]RoleParams, error) {
 	localQuery := *roleQuery
 
 	var customPredicate []string
@@ -185,9 +188,35 @@ func ListRoles(conn *sqlx.DB, likePattern string) ([]RoleParams, error) {
 	q := localQuery.CustomPredicate(customPredicate).QueryPredicate(map[string]string{})
 
 	var c []RoleParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
+
+var rolePasswordUpdatedAtQuery = NewBaseQuery(`
+	SELECT updated_at
+	FROM mz_internal.mz_role_auth`)
+
+// ScanRolePasswordUpdatedAt returns when the password of the role was last
+// set. The time is not valid if the role has no password or the Materialize
+// version does not track it.
+func ScanRolePasswordUpdatedAt(ctx context.Context, conn *sqlx.DB, roleId string) (sql.NullTime, error) {
+	p := map[string]string{"role_id": roleId}
+	q := rolePasswordUpdatedAtQuery.QueryPredicate(p)
+
+	var t sql.NullTime
+	if err := conn.GetContext(ctx, &t, q); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sql.NullTime{}, nil
+		}
+		if isUndefinedObject(err) {
+			log.Printf("[DEBUG] role auth view unavailable for role %s: %s", roleId, err)
+			return sql.NullTime{}, nil
+		}
+		return sql.NullTime{}, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,264 @@
+package materialize
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	EffectivePrivilegeObject  = "object"
+	EffectivePrivilegeDefault = "default"
+	EffectivePrivilegeSystem  = "system"
+)
+
+// EffectivePrivilege is a single privilege a role holds, either directly or
+// through one of the roles it is a member of.
+type EffectivePrivilege struct {
+	Kind         string
+	ObjectId     string
+	ObjectType   string
+	ObjectName   string
+	SchemaName   string
+	DatabaseName string
+	// TargetRole is only set for default privileges and is the role whose
+	// newly created objects receive the privilege.
+	TargetRole     string
+	Privilege      string
+	GrantedViaRole string
+}
+
+type ObjectPrivilegeParams struct {
+	ObjectId     sql.NullString `db:"id"`
+	ObjectName   sql.NullString `db:"object_name"`
+	ObjectType   sql.NullString `db:"object_type"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	Privileges   StringArray    `db:"privileges"`
+}
+
+var objectPrivilegeQuery = NewBaseQuery(`
+	SELECT
+		objects.id,
+		objects.name AS object_name,
+		objects.object_type,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		objects.privileges
+	FROM (
+		SELECT id, name, type AS object_type, schema_id, NULL AS database_id, privileges FROM mz_relations
+		UNION ALL
+		SELECT id, name, 'connection', schema_id, NULL, privileges FROM mz_connections
+		UNION ALL
+		SELECT id, name, 'secret', schema_id, NULL, privileges FROM mz_secrets
+		UNION ALL
+		SELECT id, name, 'type', schema_id, NULL, privileges FROM mz_types
+		UNION ALL
+		SELECT id, name, 'schema', NULL, database_id, privileges FROM mz_schemas
+		UNION ALL
+		SELECT id, name, 'database', NULL, NULL, privileges FROM mz_databases
+		UNION ALL
+		SELECT id, name, 'cluster', NULL, NULL, privileges FROM mz_clusters
+		UNION ALL
+		SELECT id, name, 'network-policy', NULL, NULL, privileges FROM mz_internal.mz_network_policies
+	) objects
+	LEFT JOIN mz_schemas
+		ON objects.schema_id = mz_schemas.id
+	LEFT JOIN mz_databases
+		ON mz_databases.id = COALESCE(objects.database_id, mz_schemas.database_id)`)
+
+// ListObjectPrivileges returns the privileges on every object. System objects,
+// whose ids do not start with u, are only included if includeSystemObjects is set.
+func ListObjectPrivileges(ctx context.Context, conn *sqlx.DB, includeSystemObjects bool) ([]ObjectPrivilegeParams, error) {
+	localQuery := *objectPrivilegeQuery
+
+	var customPredicate []string
+	if !includeSystemObjects {
+		customPredicate = append(customPredicate, "objects.id LIKE 'u%'")
+	}
+
+	q := localQuery.CustomPredicate(customPredicate).QueryPredicate(map[string]string{})
+
+	var c []ObjectPrivilegeParams
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type DefaultPrivilegeScopeParams struct {
+	ObjectType   sql.NullString `db:"object_type"`
+	GranteeId    sql.NullString `db:"grantee_id"`
+	TargetName   sql.NullString `db:"target_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	Privileges   sql.NullString `db:"privileges"`
+}
+
+var defaultPrivilegeScopeQuery = NewBaseQuery(`
+	SELECT
+		mz_default_privileges.object_type,
+		mz_default_privileges.grantee AS grantee_id,
+		(CASE WHEN mz_default_privileges.role_id = 'p' THEN 'PUBLIC' ELSE target.name END) AS target_name,
+		COALESCE(mz_databases.name, schema_databases.name) AS database_name,
+		mz_schemas.name AS schema_name,
+		mz_default_privileges.privileges
+	FROM mz_default_privileges
+	LEFT JOIN mz_roles AS target
+		ON mz_default_privileges.role_id = target.id
+	LEFT JOIN mz_schemas
+		ON mz_default_privileges.schema_id = mz_schemas.id
+	LEFT JOIN mz_databases
+		ON mz_default_privileges.database_id = mz_databases.id
+	LEFT JOIN mz_databases AS schema_databases
+		ON mz_schemas.database_id = schema_databases.id`)
+
+func ListDefaultPrivilegeScopes(ctx context.Context, conn *sqlx.DB) ([]DefaultPrivilegeScopeParams, error) {
+	q := defaultPrivilegeScopeQuery.QueryPredicate(map[string]string{})
+
+	var c []DefaultPrivilegeScopeParams
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// InheritedRoles returns the ids of the roles whose privileges roleId holds,
+// mapped to the name of the role the privileges are granted to. This is
+// roleId itself, every role it is transitively a member of and PUBLIC.
+func InheritedRoles(ctx context.Context, conn *sqlx.DB, roleId string) (map[string]string, error) {
+	roles, err := ListRoles(ctx, conn, "")
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]string{"p": "PUBLIC"}
+	for _, r := range roles {
+		names[r.RoleId.String] = r.RoleName.String
+	}
+
+	members, err := ScanRolePrivilege(ctx, conn, "", "")
+	if err != nil {
+		return nil, err
+	}
+	memberOf := map[string][]string{}
+	for _, m := range members {
+		memberOf[m.Member.String] = append(memberOf[m.Member.String], m.RoleId.String)
+	}
+
+	inherited := map[string]string{"p": "PUBLIC"}
+	queue := []string{roleId}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := inherited[id]; ok {
+			continue
+		}
+		inherited[id] = names[id]
+		queue = append(queue, memberOf[id]...)
+	}
+
+	return inherited, nil
+}
+
+// ScanRoleEffectivePrivileges returns the object, default and system
+// privileges a role holds, including those inherited through role membership.
+// Privileges on system objects are only included if includeSystemObjects is set.
+func ScanRoleEffectivePrivileges(ctx context.Context, conn *sqlx.DB, roleId string, includeSystemObjects bool) ([]EffectivePrivilege, error) {
+	inherited, err := InheritedRoles(ctx, conn, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var privileges []EffectivePrivilege
+
+	objects, err := ListObjectPrivileges(ctx, conn, includeSystemObjects)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range objects {
+		for _, acl := range o.Privileges {
+			item := ParseMzAclString(acl)
+			via, ok := inherited[item.Grantee]
+			if !ok {
+				continue
+			}
+			for _, p := range item.Privileges {
+				privileges = append(privileges, EffectivePrivilege{
+					Kind:           EffectivePrivilegeObject,
+					ObjectId:       o.ObjectId.String,
+					ObjectType:     o.ObjectType.String,
+					ObjectName:     o.ObjectName.String,
+					SchemaName:     o.SchemaName.String,
+					DatabaseName:   o.DatabaseName.String,
+					Privilege:      p,
+					GrantedViaRole: via,
+				})
+			}
+		}
+	}
+
+	defaults, err := ListDefaultPrivilegeScopes(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range defaults {
+		via, ok := inherited[d.GranteeId.String]
+		if !ok {
+			continue
+		}
+		for _, rp := range strings.Split(d.Privileges.String, "") {
+			p, err := PrivilegeName(rp)
+			if err != nil {
+				continue
+			}
+			privileges = append(privileges, EffectivePrivilege{
+				Kind:           EffectivePrivilegeDefault,
+				ObjectType:     d.ObjectType.String,
+				SchemaName:     d.SchemaName.String,
+				DatabaseName:   d.DatabaseName.String,
+				TargetRole:     d.TargetName.String,
+				Privilege:      p,
+				GrantedViaRole: via,
+			})
+		}
+	}
+
+	system, err := ScanSystemPrivileges(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range system {
+		item := ParseMzAclString(s.Privileges)
+		via, ok := inherited[item.Grantee]
+		if !ok {
+			continue
+		}
+		for _, p := range item.Privileges {
+			privileges = append(privileges, EffectivePrivilege{
+				Kind:           EffectivePrivilegeSystem,
+				ObjectType:     "system",
+				Privilege:      p,
+				GrantedViaRole: via,
+			})
+		}
+	}
+
+	sort.SliceStable(privileges, func(i, j int) bool {
+		a, b := privileges[i], privileges[j]
+		ka := []string{a.Kind, a.ObjectType, a.DatabaseName, a.SchemaName, a.ObjectName, a.TargetRole, a.Privilege, a.GrantedViaRole}
+		kb := []string{b.Kind, b.ObjectType, b.DatabaseName, b.SchemaName, b.ObjectName, b.TargetRole, b.Privilege, b.GrantedViaRole}
+		for k := range ka {
+			if ka[k] != kb[k] {
+				return ka[k] < kb[k]
+			}
+		}
+		return false
+	})
+
+	return privileges, nil
+}
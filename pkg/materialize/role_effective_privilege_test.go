@@ -0,0 +1,71 @@
+package materialize
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanRoleEffectivePrivileges(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// joe (u1) is a member of analyst (u9), which is a member of admin (u2)
+		mock.ExpectQuery(regexp.QuoteMeta(roleQuery.QueryPredicate(map[string]string{}))).WillReturnRows(
+			mock.NewRows([]string{"id", "role_name"}).
+				AddRow("u1", "joe").
+				AddRow("u2", "admin").
+				AddRow("u9", "analyst"),
+		)
+		mock.ExpectQuery(regexp.QuoteMeta(rolePrivilegeQuery.QueryPredicate(map[string]string{}))).WillReturnRows(
+			mock.NewRows([]string{"role_id", "member", "grantor"}).
+				AddRow("u9", "u1", "s1").
+				AddRow("u2", "u9", "s1").
+				AddRow("u7", "u8", "s1"),
+		)
+		testhelpers.MockObjectPrivilegeScan(mock, `WHERE objects.id LIKE 'u%'`)
+		testhelpers.MockDefaultPrivilegeScopeScan(mock)
+		testhelpers.MockSystemPrivilege(mock)
+
+		privileges, err := ScanRoleEffectivePrivileges(context.Background(), db, "u1", false)
+		r.NoError(err)
+
+		r.Equal([]EffectivePrivilege{
+			{Kind: "default", ObjectType: "table", TargetRole: "PUBLIC", Privilege: "UPDATE", GrantedViaRole: "analyst"},
+			{Kind: "default", ObjectType: "table", DatabaseName: "database", SchemaName: "schema", TargetRole: "PUBLIC", Privilege: "SELECT", GrantedViaRole: "joe"},
+			{Kind: "object", ObjectId: "u2", ObjectType: "cluster", ObjectName: "cluster", Privilege: "CREATE", GrantedViaRole: "admin"},
+			{Kind: "object", ObjectId: "u2", ObjectType: "cluster", ObjectName: "cluster", Privilege: "USAGE", GrantedViaRole: "admin"},
+			{Kind: "object", ObjectId: "u3", ObjectType: "schema", ObjectName: "schema", DatabaseName: "database", Privilege: "USAGE", GrantedViaRole: "PUBLIC"},
+			{Kind: "object", ObjectId: "u5", ObjectType: "table", ObjectName: "table", SchemaName: "schema", DatabaseName: "database", Privilege: "SELECT", GrantedViaRole: "joe"},
+			{Kind: "object", ObjectId: "u5", ObjectType: "table", ObjectName: "table", SchemaName: "schema", DatabaseName: "database", Privilege: "UPDATE", GrantedViaRole: "analyst"},
+		}, privileges)
+	})
+}
+
+func TestScanRoleEffectivePrivilegesSystemObjects(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(regexp.QuoteMeta(roleQuery.QueryPredicate(map[string]string{}))).WillReturnRows(
+			mock.NewRows([]string{"id", "role_name"}).AddRow("u1", "joe"),
+		)
+		mock.ExpectQuery(regexp.QuoteMeta(rolePrivilegeQuery.QueryPredicate(map[string]string{}))).WillReturnRows(
+			mock.NewRows([]string{"role_id", "member", "grantor"}),
+		)
+		testhelpers.MockObjectPrivilegeScan(mock, "")
+		testhelpers.MockDefaultPrivilegeScopeScan(mock)
+		testhelpers.MockSystemPrivilege(mock)
+
+		privileges, err := ScanRoleEffectivePrivileges(context.Background(), db, "u1", true)
+		r.NoError(err)
+
+		r.Contains(privileges, EffectivePrivilege{
+			Kind: "object", ObjectId: "s1", ObjectType: "table", ObjectName: "mz_tables", SchemaName: "mz_catalog", Privilege: "SELECT", GrantedViaRole: "PUBLIC",
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -13,9 +14,9 @@ type RoleParameterBuilder struct {
 	variableValue string
 }
 
-func NewRoleParameterBuilder(conn *sqlx.DB, roleName, variableName, variableValue string) *RoleParameterBuilder {
+func NewRoleParameterBuilder(ctx context.Context, conn *sqlx.DB, roleName, variableName, variableValue string) *RoleParameterBuilder {
 	return &RoleParameterBuilder{
-		ddl:           Builder{conn, System},
+		ddl:           Builder{ctx, conn, System},
 		roleName:      roleName,
 		variableName:  variableName,
 		variableValue: variableValue,
@@ -33,10 +34,10 @@ func (b *RoleParameterBuilder) Reset() error {
 }
 
 // TODO: Once possible, implement ShowRoleParameter
-func ShowRoleParameter(conn *sqlx.DB, roleName, variableName string) (string, error) {
+func ShowRoleParameter(ctx context.Context, conn *sqlx.DB, roleName, variableName string) (string, error) {
 	var variableValue string
 	query := fmt.Sprintf(`SHOW %s;`, QuoteIdentifier(variableName))
-	err := conn.QueryRow(query).Scan(&variableValue)
+	err := conn.QueryRowContext(ctx, query).Scan(&variableValue)
 	if err != nil {
 		return "", fmt.Errorf("error reading variable %s for role %s: %v", variableName, roleName, err)
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -16,7 +17,7 @@ func TestRoleParameterSet(t *testing.T) {
 
 		mock.ExpectExec(`ALTER ROLE "` + roleName + `" SET "` + variableName + `" TO '` + variableValue + `';`).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		rpBuilder := NewRoleParameterBuilder(db, roleName, variableName, variableValue)
+		rpBuilder := NewRoleParameterBuilder(context.Background(), db, roleName, variableName, variableValue)
 		if err := rpBuilder.Set(); err != nil {
 			t.Fatalf("unexpected error during Set: %v", err)
 		}
@@ -34,7 +35,7 @@ func TestRoleParameterReset(t *testing.T) {
 
 		mock.ExpectExec(`ALTER ROLE "` + roleName + `" RESET "` + variableName + `";`).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		rpBuilder := NewRoleParameterBuilder(db, roleName, variableName, "")
+		rpBuilder := NewRoleParameterBuilder(context.Background(), db, roleName, variableName, "")
 		if err := rpBuilder.Reset(); err != nil {
 			t.Fatalf("unexpected error during Reset: %v", err)
 		}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -17,7 +18,7 @@ func TestRoleCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 
 		if err := b.Create(); err != nil {
@@ -33,7 +34,7 @@ func TestRoleAlter(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		if err := NewRoleBuilder(db, o).Alter("INHERIT"); err != nil {
+		if err := NewRoleBuilder(context.Background(), db, o).Alter("INHERIT"); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -46,7 +47,7 @@ func TestRoleCreateWithSuperuser(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 		b.Superuser(true)
 
@@ -63,7 +64,7 @@ func TestRoleCreateWithNoSuperuser(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 		b.Superuser(false)
 
@@ -80,7 +81,7 @@ func TestRoleCreateWithLogin(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 		b.Login(true)
 
@@ -97,7 +98,7 @@ func TestRoleCreateWithPasswordAndLogin(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 		b.Password("password123")
 		b.Login(true)
@@ -115,7 +116,7 @@ func TestRoleCreateWithPasswordNoLogin(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 		b.Password("password123")
 		b.Login(false)
@@ -133,7 +134,7 @@ func TestRoleCreateWithAllOptions(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 		b.Inherit()
 		b.Password("password123")
 		b.Login(true)
@@ -152,7 +153,7 @@ func TestRoleCreateNoOptions(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		b := NewRoleBuilder(db, o)
+		b := NewRoleBuilder(context.Background(), db, o)
 
 		if err := b.Create(); err != nil {
 			t.Fatal(err)
@@ -167,7 +168,7 @@ func TestRoleAlterLogin(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		if err := NewRoleBuilder(db, o).AlterLogin(true); err != nil {
+		if err := NewRoleBuilder(context.Background(), db, o).AlterLogin(true); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -180,7 +181,7 @@ func TestRoleAlterNoLogin(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		if err := NewRoleBuilder(db, o).AlterLogin(false); err != nil {
+		if err := NewRoleBuilder(context.Background(), db, o).AlterLogin(false); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -193,7 +194,7 @@ func TestRoleDrop(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "role"}
-		if err := NewRoleBuilder(db, o).Drop(); err != nil {
+		if err := NewRoleBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -203,7 +204,7 @@ func TestListRolesWithoutPattern(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		testhelpers.MockRoleScan(mock, "")
 
-		if _, err := ListRoles(db, ""); err != nil {
+		if _, err := ListRoles(context.Background(), db, ""); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -213,7 +214,7 @@ func TestListRolesWithPattern(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		testhelpers.MockRoleScan(mock, "WHERE mz_roles.name LIKE 'prod_%'")
 
-		if _, err := ListRoles(db, "prod_%"); err != nil {
+		if _, err := ListRoles(context.Background(), db, "prod_%"); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -1,9 +1,9 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -15,9 +15,9 @@ type SchemaBuilder struct {
 	databaseName string
 }
 
-func NewSchemaBuilder(conn *sqlx.DB, obj MaterializeObject) *SchemaBuilder {
+func NewSchemaBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SchemaBuilder {
 	return &SchemaBuilder{
-		ddl:          Builder{conn, Schema},
+		ddl:          Builder{ctx, conn, Schema},
 		schemaName:   obj.Name,
 		databaseName: obj.DatabaseName,
 	}
@@ -38,6 +38,12 @@ func (b *SchemaBuilder) Rename(newName string) error {
 	return b.ddl.rename(old, new)
 }
 
+// Swap atomically exchanges the names of this schema and another schema in
+// the same database.
+func (b *SchemaBuilder) Swap(otherName string) error {
+	return b.ddl.swap(b.QualifiedName(), QuoteIdentifier(otherName))
+}
+
 func (b *SchemaBuilder) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -73,7 +79,7 @@ var schemaQuery = NewBaseQuery(`
 	) comments
 		ON mz_schemas.id = comments.id`)
 
-func SchemaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SchemaId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_schemas.name":   obj.Name,
 		"mz_databases.name": obj.DatabaseName,
@@ -81,19 +87,19 @@ func SchemaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := schemaQuery.QueryPredicate(p)
 
 	var c SchemaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.SchemaId.String, nil
 }
 
-func ScanSchema(conn *sqlx.DB, identifier string, byName bool) (SchemaParams, error) {
+func ScanSchema(ctx context.Context, conn *sqlx.DB, identifier string, byName bool) (SchemaParams, error) {
 	var p map[string]string
 	if byName {
-		parts := strings.SplitN(identifier, "|", 2)
-		if len(parts) != 2 {
-			return SchemaParams{}, fmt.Errorf("schema name identifier must be database|schema, got %q", identifier)
+		parts, err := ParseNameId(identifier, 2)
+		if err != nil || len(parts) != 2 {
+			return SchemaParams{}, fmt.Errorf("schema name identifier must be database|schema or \"database\".\"schema\", got %q", identifier)
 		}
 		p = map[string]string{
 			"mz_databases.name": parts[0],
@@ -107,19 +113,19 @@ func ScanSchema(conn *sqlx.DB, identifier string, byName bool) (SchemaParams, er
 	q := schemaQuery.QueryPredicate(p)
 
 	var c SchemaParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSchemas(conn *sqlx.DB, databaseName string) ([]SchemaParams, error) {
+func ListSchemas(ctx context.Context, conn *sqlx.DB, databaseName string) ([]SchemaParams, error) {
 	p := map[string]string{"mz_databases.name": databaseName}
 	q := schemaQuery.QueryPredicate(p)
 
 	var c []SchemaParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
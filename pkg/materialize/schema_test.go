@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -17,7 +18,7 @@ func TestSchemaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "schema", DatabaseName: "database"}
-		if err := NewSchemaBuilder(db, o).Create(); err != nil {
+		if err := NewSchemaBuilder(context.Background(), db, o).Create(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -30,7 +31,20 @@ func TestSchemaDrop(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "schema", DatabaseName: "database"}
-		if err := NewSchemaBuilder(db, o).Drop(); err != nil {
+		if err := NewSchemaBuilder(context.Background(), db, o).Drop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSchemaSwap(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SCHEMA "database"."blue" SWAP WITH "green";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "blue", DatabaseName: "database"}
+		if err := NewSchemaBuilder(context.Background(), db, o).Swap("green"); err != nil {
 			t.Fatal(err)
 		}
 	})
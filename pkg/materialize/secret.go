@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -16,9 +17,9 @@ type SecretBuilder struct {
 	value        string
 }
 
-func NewSecretBuilder(conn *sqlx.DB, obj MaterializeObject) *SecretBuilder {
+func NewSecretBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SecretBuilder {
 	return &SecretBuilder{
-		ddl:          Builder{conn, Secret},
+		ddl:          Builder{ctx, conn, Secret},
 		secretName:   obj.Name,
 		schemaName:   obj.SchemaName,
 		databaseName: obj.DatabaseName,
@@ -88,7 +89,7 @@ var secretQuery = NewBaseQuery(`
 	) comments
 		ON mz_secrets.id = comments.id`)
 
-func SecretId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SecretId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_secrets.name":   obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -97,28 +98,28 @@ func SecretId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := secretQuery.QueryPredicate(p)
 
 	var c SecretParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.SecretId.String, nil
 }
 
-func ScanSecret(conn *sqlx.DB, id string) (SecretParams, error) {
+func ScanSecret(ctx context.Context, conn *sqlx.DB, id string) (SecretParams, error) {
 	p := map[string]string{
 		"mz_secrets.id": id,
 	}
 	q := secretQuery.QueryPredicate(p)
 
 	var c SecretParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSecrets(conn *sqlx.DB, schemaName, databaseName string) ([]SecretParams, error) {
+func ListSecrets(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]SecretParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -126,7 +127,7 @@ func ListSecrets(conn *sqlx.DB, schemaName, databaseName string) ([]SecretParams
 	q := secretQuery.QueryPredicate(p)
 
 	var c []SecretParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -18,7 +19,7 @@ func TestSecretCreate(t *testing.T) {
 			`CREATE SECRET "database"."schema"."secret" AS 'c2VjcmV0Cg';`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSecretBuilder(db, secret)
+		b := NewSecretBuilder(context.Background(), db, secret)
 		b.Value(`c2VjcmV0Cg`)
 
 		if err := b.Create(); err != nil {
@@ -33,7 +34,7 @@ func TestSecretCreateEscapedValue(t *testing.T) {
 			`CREATE SECRET "database"."schema"."secret" AS 'c2Vjcm''V0Cg';`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSecretBuilder(db, secret)
+		b := NewSecretBuilder(context.Background(), db, secret)
 		b.Value(`c2Vjcm'V0Cg`)
 
 		if err := b.Create(); err != nil {
@@ -48,7 +49,7 @@ func TestSecretRename(t *testing.T) {
 			`ALTER SECRET "database"."schema"."secret" RENAME TO "new_secret";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSecretBuilder(db, secret)
+		b := NewSecretBuilder(context.Background(), db, secret)
 
 		if err := b.Rename("new_secret"); err != nil {
 			t.Fatal(err)
@@ -62,7 +63,7 @@ func TestSecretUpdateValue(t *testing.T) {
 			`ALTER SECRET "database"."schema"."secret" AS 'c2VjcmV0Cgdd';`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSecretBuilder(db, secret)
+		b := NewSecretBuilder(context.Background(), db, secret)
 
 		if err := b.UpdateValue(`c2VjcmV0Cgdd`); err != nil {
 			t.Fatal(err)
@@ -76,7 +77,7 @@ func TestSecretUpdateEscapedValue(t *testing.T) {
 			`ALTER SECRET "database"."schema"."secret" AS 'c2Vjcm''V0Cgdd';`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSecretBuilder(db, secret)
+		b := NewSecretBuilder(context.Background(), db, secret)
 
 		if err := b.UpdateValue(`c2Vjcm'V0Cgdd`); err != nil {
 			t.Fatal(err)
@@ -90,7 +91,7 @@ func TestSecretDrop(t *testing.T) {
 			`DROP SECRET "database"."schema"."secret";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		if err := NewSecretBuilder(db, secret).Drop(); err != nil {
+		if err := NewSecretBuilder(context.Background(), db, secret).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/jmoiron/sqlx"
@@ -13,9 +14,9 @@ type Sink struct {
 	DatabaseName string
 }
 
-func NewSink(conn *sqlx.DB, obj MaterializeObject) *Sink {
+func NewSink(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *Sink {
 	return &Sink{
-		ddl:          Builder{conn, BaseSink},
+		ddl:          Builder{ctx, conn, BaseSink},
 		SinkName:     obj.Name,
 		SchemaName:   obj.SchemaName,
 		DatabaseName: obj.DatabaseName,
@@ -86,7 +87,7 @@ var sinkQuery = NewBaseQuery(`
 	) comments
 		ON mz_sinks.id = comments.id`)
 
-func SinkId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SinkId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_sinks.name":     obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -95,25 +96,25 @@ func SinkId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := sinkQuery.QueryPredicate(p)
 
 	var c SinkParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.SinkId.String, nil
 }
 
-func ScanSink(conn *sqlx.DB, id string) (SinkParams, error) {
+func ScanSink(ctx context.Context, conn *sqlx.DB, id string) (SinkParams, error) {
 	q := sinkQuery.QueryPredicate(map[string]string{"mz_sinks.id": id})
 
 	var c SinkParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSinks(conn *sqlx.DB, schemaName, databaseName string) ([]SinkParams, error) {
+func ListSinks(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]SinkParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -121,7 +122,7 @@ func ListSinks(conn *sqlx.DB, schemaName, databaseName string) ([]SinkParams, er
 	q := sinkQuery.QueryPredicate(p)
 
 	var c []SinkParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
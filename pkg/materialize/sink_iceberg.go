@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -20,8 +21,8 @@ type SinkIcebergBuilder struct {
 	commitInterval           string
 }
 
-func NewSinkIcebergBuilder(conn *sqlx.DB, obj MaterializeObject) *SinkIcebergBuilder {
-	b := Builder{conn, BaseSink}
+func NewSinkIcebergBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SinkIcebergBuilder {
+	b := Builder{ctx, conn, BaseSink}
 	return &SinkIcebergBuilder{
 		Sink: Sink{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestSinkIcebergCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "iceberg_sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkIcebergBuilder(db, o)
+		b := NewSinkIcebergBuilder(context.Background(), db, o)
 		b.ClusterName("my_cluster")
 		b.From(IdentifierSchemaStruct{Name: "my_view", SchemaName: "schema", DatabaseName: "database"})
 		b.IcebergCatalogConnection(IdentifierSchemaStruct{Name: "iceberg_catalog", SchemaName: "schema", DatabaseName: "database"})
@@ -38,7 +39,7 @@ func TestSinkIcebergCreateWithMultipleKeys(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "iceberg_sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkIcebergBuilder(db, o)
+		b := NewSinkIcebergBuilder(context.Background(), db, o)
 		b.ClusterName("my_cluster")
 		b.From(IdentifierSchemaStruct{Name: "my_view", SchemaName: "schema", DatabaseName: "database"})
 		b.IcebergCatalogConnection(IdentifierSchemaStruct{Name: "iceberg_catalog", SchemaName: "schema", DatabaseName: "database"})
@@ -61,7 +62,7 @@ func TestSinkIcebergCreateWithKeyNotEnforced(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "iceberg_sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkIcebergBuilder(db, o)
+		b := NewSinkIcebergBuilder(context.Background(), db, o)
 		b.ClusterName("my_cluster")
 		b.From(IdentifierSchemaStruct{Name: "my_view", SchemaName: "schema", DatabaseName: "database"})
 		b.IcebergCatalogConnection(IdentifierSchemaStruct{Name: "iceberg_catalog", SchemaName: "schema", DatabaseName: "database"})
@@ -85,7 +86,7 @@ func TestSinkIcebergCreateMinimal(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "iceberg_sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkIcebergBuilder(db, o)
+		b := NewSinkIcebergBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "my_view", SchemaName: "schema", DatabaseName: "database"})
 		b.IcebergCatalogConnection(IdentifierSchemaStruct{Name: "iceberg_catalog", SchemaName: "schema", DatabaseName: "database"})
 		b.Namespace("ns")
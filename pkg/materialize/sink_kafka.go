@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -44,8 +45,8 @@ type SinkKafkaBuilder struct {
 	partitionBy            string
 }
 
-func NewSinkKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SinkKafkaBuilder {
-	b := Builder{conn, BaseSink}
+func NewSinkKafkaBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SinkKafkaBuilder {
+	b := Builder{ctx, conn, BaseSink}
 	return &SinkKafkaBuilder{
 		Sink: Sink{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
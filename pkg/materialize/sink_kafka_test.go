@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -24,7 +25,7 @@ func TestSinkKafkaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -60,7 +61,7 @@ func TestSinkKafkaSnapshotCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -96,7 +97,7 @@ func TestSinkKafkaSnapshotDisabledCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -132,7 +133,7 @@ func TestSinkKafkaSnapshotUnsetCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -156,7 +157,7 @@ func TestSinkKafkaSizeSnapshotCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -191,7 +192,7 @@ func TestSinkKafkaJsonCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -216,7 +217,7 @@ func TestSinkKafkaKeyCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -243,7 +244,7 @@ func TestSinkKafkaKeyNotEnforcedCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.ClusterName("my_io_cluster")
@@ -273,7 +274,7 @@ func TestSinkKafkaAvroDocsTypeCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(from)
 		b.KafkaConnection(IdentifierSchemaStruct{
 			Name:         "kafka_connection",
@@ -318,7 +319,7 @@ func TestSinkKafkaAvroDocsColumnCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(from)
 		b.KafkaConnection(IdentifierSchemaStruct{
 			Name:         "kafka_connection",
@@ -373,7 +374,7 @@ func TestSinkKafkaAvroDocsCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(from)
 		b.KafkaConnection(IdentifierSchemaStruct{
 			Name:         "kafka_connection",
@@ -429,7 +430,7 @@ func TestSinkKafkaHeadersCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -466,7 +467,7 @@ func TestSinkKafkaTopicOptionsCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -498,7 +499,7 @@ func TestSinkKafkaTopicOptionsWithCompressionCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
@@ -545,7 +546,7 @@ func TestSinkKafkaAvroCompatibilityLevelsCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(from)
 		b.KafkaConnection(IdentifierSchemaStruct{
 			Name:         "kafka_connection",
@@ -586,7 +587,7 @@ func TestSinkKafkaPartitionByCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSinkKafkaBuilder(db, o)
+		b := NewSinkKafkaBuilder(context.Background(), db, o)
 		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
 		b.Topic("testdrive-snk1-seed")
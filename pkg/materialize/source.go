@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -80,9 +81,9 @@ type Source struct {
 	DatabaseName string
 }
 
-func NewSource(conn *sqlx.DB, obj MaterializeObject) *Source {
+func NewSource(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *Source {
 	return &Source{
-		ddl:          Builder{conn, BaseSource},
+		ddl:          Builder{ctx, conn, BaseSource},
 		SourceName:   obj.Name,
 		SchemaName:   obj.SchemaName,
 		DatabaseName: obj.DatabaseName,
@@ -170,7 +171,7 @@ var sourceQuery = NewBaseQuery(`
 		LEFT JOIN mz_internal.mz_webhook_sources
 			ON mz_sources.id = mz_webhook_sources.id`)
 
-func SourceId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_sources.name":   obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -179,25 +180,25 @@ func SourceId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := sourceQuery.QueryPredicate(p)
 
 	var c SourceParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.SourceId.String, nil
 }
 
-func ScanSource(conn *sqlx.DB, id string) (SourceParams, error) {
+func ScanSource(ctx context.Context, conn *sqlx.DB, id string) (SourceParams, error) {
 	q := sourceQuery.QueryPredicate(map[string]string{"mz_sources.id": id})
 
 	var c SourceParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListSources(conn *sqlx.DB, schemaName, databaseName string) ([]SourceParams, error) {
+func ListSources(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]SourceParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -205,7 +206,7 @@ func ListSources(conn *sqlx.DB, schemaName, databaseName string) ([]SourceParams
 	q := sourceQuery.QueryPredicate(p)
 
 	var c []SourceParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
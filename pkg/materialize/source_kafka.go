@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -85,8 +86,8 @@ type SourceKafkaBuilder struct {
 	exposeProgress   IdentifierSchemaStruct
 }
 
-func NewSourceKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceKafkaBuilder {
-	b := Builder{conn, BaseSink}
+func NewSourceKafkaBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceKafkaBuilder {
+	b := Builder{ctx, conn, BaseSink}
 	return &SourceKafkaBuilder{
 		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
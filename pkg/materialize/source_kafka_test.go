@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -20,7 +21,7 @@ func TestResourceSourceKafkaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSourceKafkaBuilder(db, o)
+		b := NewSourceKafkaBuilder(context.Background(), db, o)
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"})
 		b.Topic("events")
 		b.Format(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}}})
@@ -51,7 +52,7 @@ func TestResourceSourceKafkaCreateWithUpsertOptions(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSourceKafkaBuilder(db, o)
+		b := NewSourceKafkaBuilder(context.Background(), db, o)
 		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"})
 		b.Topic("events")
 		b.Format(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}}})
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -64,8 +65,8 @@ type SourceLoadgenBuilder struct {
 	exposeProgress    IdentifierSchemaStruct
 }
 
-func NewSourceLoadgenBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceLoadgenBuilder {
-	b := Builder{conn, BaseSource}
+func NewSourceLoadgenBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceLoadgenBuilder {
+	b := Builder{ctx, conn, BaseSource}
 	return &SourceLoadgenBuilder{
 		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
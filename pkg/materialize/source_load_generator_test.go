@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -19,7 +20,7 @@ func TestSourceLoadgenAuctionCreate(t *testing.T) {
 			FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b := NewSourceLoadgenBuilder(context.Background(), db, sourceLoadgen)
 		b.LoadGeneratorType("AUCTION")
 		b.AuctionOptions(AuctionOptions{
 			TickInterval: "1s",
@@ -40,7 +41,7 @@ func TestSourceLoadgenMarketingCreate(t *testing.T) {
 			FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b := NewSourceLoadgenBuilder(context.Background(), db, sourceLoadgen)
 		b.LoadGeneratorType("MARKETING")
 		b.MarketingOptions(MarketingOptions{
 			TickInterval: "1s",
@@ -61,7 +62,7 @@ func TestSourceLoadgenTPCHParamsCreate(t *testing.T) {
 			FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b := NewSourceLoadgenBuilder(context.Background(), db, sourceLoadgen)
 		b.LoadGeneratorType("TPCH")
 		b.TPCHOptions(TPCHOptions{
 			TickInterval: "1s",
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -19,8 +20,8 @@ type SourceMySQLBuilder struct {
 	exposeProgress  IdentifierSchemaStruct
 }
 
-func NewSourceMySQLBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceMySQLBuilder {
-	b := Builder{conn, BaseSource}
+func NewSourceMySQLBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceMySQLBuilder {
+	b := Builder{ctx, conn, BaseSource}
 	return &SourceMySQLBuilder{
 		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
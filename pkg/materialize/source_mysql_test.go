@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -20,7 +21,7 @@ func TestSourceMySQLAllTablesCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceMySQLBuilder(db, sourceMySQL)
+		b := NewSourceMySQLBuilder(context.Background(), db, sourceMySQL)
 		b.AllTables()
 		b.MySQLConnection(IdentifierSchemaStruct{Name: "mysql_connection", SchemaName: "schema", DatabaseName: "database"})
 
@@ -36,7 +37,7 @@ func TestSourceMySQLSpecificTablesCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM MYSQL CONNECTION "database"."schema"."mysql_connection" FOR TABLES \("schema1"."table_1" AS "database"."schema"."s1_table_1", "schema2"."table_2" AS "database"."schema"."table_alias"\) EXPOSE PROGRESS AS "database"."schema"."progress";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceMySQLBuilder(db, sourceMySQL)
+		b := NewSourceMySQLBuilder(context.Background(), db, sourceMySQL)
 		b.MySQLConnection(IdentifierSchemaStruct{Name: "mysql_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.AllTables()
 		b.Tables([]TableStruct{
@@ -65,7 +66,7 @@ func TestSourceMySQLAddSubsource(t *testing.T) {
 			`ALTER SOURCE "database"."schema"."source" ADD SUBSOURCE "schema"."table_1", "schema"."table_2" AS "database"."schema"."table_alias";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSource(db, sourceMySQL)
+		b := NewSource(context.Background(), db, sourceMySQL)
 		if err := b.AddSubsource(tableInputMySQL, []string{}); err != nil {
 			t.Fatal(err)
 		}
@@ -78,7 +79,7 @@ func TestSourceMySQLDropSubsource(t *testing.T) {
 			`DROP SOURCE "database"."schema"."table_1", "database"."schema"."table_alias"`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceMySQLBuilder(db, sourceMySQL)
+		b := NewSourceMySQLBuilder(context.Background(), db, sourceMySQL)
 		if err := b.DropSubsource(tableInputMySQL); err != nil {
 			t.Fatal(err)
 		}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -16,11 +17,13 @@ type SourcePostgresBuilder struct {
 	textColumns        []string
 	excludeColumns     []string
 	table              []TableStruct
+	allTables          bool
+	schemas            []string
 	exposeProgress     IdentifierSchemaStruct
 }
 
-func NewSourcePostgresBuilder(conn *sqlx.DB, obj MaterializeObject) *SourcePostgresBuilder {
-	b := Builder{conn, BaseSource}
+func NewSourcePostgresBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourcePostgresBuilder {
+	b := Builder{ctx, conn, BaseSource}
 	return &SourcePostgresBuilder{
 		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -61,6 +64,16 @@ func (b *SourcePostgresBuilder) Table(t []TableStruct) *SourcePostgresBuilder {
 	return b
 }
 
+func (b *SourcePostgresBuilder) AllTables() *SourcePostgresBuilder {
+	b.allTables = true
+	return b
+}
+
+func (b *SourcePostgresBuilder) Schemas(s []string) *SourcePostgresBuilder {
+	b.schemas = s
+	return b
+}
+
 func (b *SourcePostgresBuilder) ExposeProgress(e IdentifierSchemaStruct) *SourcePostgresBuilder {
 	b.exposeProgress = e
 	return b
@@ -112,6 +125,14 @@ func (b *SourcePostgresBuilder) Create() error {
 			}
 		}
 		q.WriteString(`)`)
+	} else if b.allTables {
+		q.WriteString(` FOR ALL TABLES`)
+	} else if len(b.schemas) > 0 {
+		var schemas []string
+		for _, s := range b.schemas {
+			schemas = append(schemas, QuoteIdentifier(s))
+		}
+		q.WriteString(fmt.Sprintf(` FOR SCHEMAS (%s)`, strings.Join(schemas, ", ")))
 	}
 
 	if b.exposeProgress.Name != "" {
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -24,7 +25,7 @@ func TestSourcePostgresSpecificTablesCreate(t *testing.T) {
 			EXPOSE PROGRESS AS "database"."schema"."progress";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourcePostgresBuilder(db, sourcePostgres)
+		b := NewSourcePostgresBuilder(context.Background(), db, sourcePostgres)
 		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.Publication("mz_source")
 		b.TextColumns([]string{"table.unsupported_type_1", "table.unsupported_type_2"})
@@ -56,7 +57,7 @@ func TestSourceAddSubsource(t *testing.T) {
 			ADD SUBSOURCE "schema"."table_1", "schema"."table_2" AS "database"."schema"."table_alias";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSource(db, sourcePostgres)
+		b := NewSource(context.Background(), db, sourcePostgres)
 		if err := b.AddSubsource(tableInput, []string{}); err != nil {
 			t.Fatal(err)
 		}
@@ -71,7 +72,7 @@ func TestSourceAddSubsourceTextColumns(t *testing.T) {
 			WITH \(TEXT COLUMNS \[table_1.column_1, table_2.column_2\]\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSource(db, sourcePostgres)
+		b := NewSource(context.Background(), db, sourcePostgres)
 		if err := b.AddSubsource(tableInput, []string{"table_1.column_1", "table_2.column_2"}); err != nil {
 			t.Fatal(err)
 		}
@@ -84,7 +85,7 @@ func TestSourceDropSubsource(t *testing.T) {
 			`DROP SOURCE "database"."schema"."table_1", "database"."schema"."table_alias";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourcePostgresBuilder(db, sourcePostgres)
+		b := NewSourcePostgresBuilder(context.Background(), db, sourcePostgres)
 		if err := b.DropSubsource(tableInput); err != nil {
 			t.Fatal(err)
 		}
@@ -97,7 +98,7 @@ func TestSourcePostgresWithExcludeColumnsCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM POSTGRES CONNECTION "database"."schema"."pg_connection" \(PUBLICATION 'mz_source', EXCLUDE COLUMNS \(public.users.image_data, public.posts.binary_data\)\) FOR TABLES \("schema1"."table_1" AS "database"."schema"."s1_table_1"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourcePostgresBuilder(db, sourcePostgres)
+		b := NewSourcePostgresBuilder(context.Background(), db, sourcePostgres)
 		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.Publication("mz_source")
 		b.ExcludeColumns([]string{"public.users.image_data", "public.posts.binary_data"})
@@ -121,7 +122,7 @@ func TestSourcePostgresWithTextAndExcludeColumnsCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM POSTGRES CONNECTION "database"."schema"."pg_connection" \(PUBLICATION 'mz_source', TEXT COLUMNS \(public.users.description, public.posts.content\), EXCLUDE COLUMNS \(public.users.image_data, public.posts.binary_data\)\) FOR TABLES \("schema1"."table_1" AS "database"."schema"."s1_table_1", "schema2"."table_2" AS "database"."schema"."s2_table_2"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourcePostgresBuilder(db, sourcePostgres)
+		b := NewSourcePostgresBuilder(context.Background(), db, sourcePostgres)
 		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.Publication("mz_source")
 		b.TextColumns([]string{"public.users.description", "public.posts.content"})
@@ -144,3 +145,37 @@ func TestSourcePostgresWithTextAndExcludeColumnsCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestSourcePostgresAllTablesCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" FROM POSTGRES CONNECTION "database"."schema"."pg_connection" \(PUBLICATION 'mz_source'\) FOR ALL TABLES;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourcePostgresBuilder(context.Background(), db, sourcePostgres)
+		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Publication("mz_source")
+		b.AllTables()
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourcePostgresSchemasCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" FROM POSTGRES CONNECTION "database"."schema"."pg_connection" \(PUBLICATION 'mz_source'\) FOR SCHEMAS \("public", "app"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourcePostgresBuilder(context.Background(), db, sourcePostgres)
+		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Publication("mz_source")
+		b.Schemas([]string{"public", "app"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -36,41 +37,41 @@ var sourceReferenceQuery = NewBaseQuery(`
     JOIN mz_databases sd ON ss.database_id = sd.id
 `)
 
-func SourceReferenceId(conn *sqlx.DB, sourceId string) (string, error) {
+func SourceReferenceId(ctx context.Context, conn *sqlx.DB, sourceId string) (string, error) {
 	p := map[string]string{
 		"sr.source_id": sourceId,
 	}
 	q := sourceReferenceQuery.QueryPredicate(p)
 
 	var s SourceReferenceParams
-	if err := conn.Get(&s, q); err != nil {
+	if err := conn.GetContext(ctx, &s, q); err != nil {
 		return "", err
 	}
 
 	return s.SourceId.String, nil
 }
 
-func ScanSourceReference(conn *sqlx.DB, id string) (SourceReferenceParams, error) {
+func ScanSourceReference(ctx context.Context, conn *sqlx.DB, id string) (SourceReferenceParams, error) {
 	q := sourceReferenceQuery.QueryPredicate(map[string]string{"sr.source_id": id})
 
 	var s SourceReferenceParams
-	if err := conn.Get(&s, q); err != nil {
+	if err := conn.GetContext(ctx, &s, q); err != nil {
 		return s, err
 	}
 
 	return s, nil
 }
 
-func refreshSourceReferences(conn *sqlx.DB, sourceName, schemaName, databaseName string) error {
+func refreshSourceReferences(ctx context.Context, conn *sqlx.DB, sourceName, schemaName, databaseName string) error {
 	query := fmt.Sprintf(`ALTER SOURCE %s REFRESH REFERENCES`, QualifiedName(databaseName, schemaName, sourceName))
-	_, err := conn.Exec(query)
+	_, err := conn.ExecContext(ctx, query)
 	return err
 }
 
-func ListSourceReferences(conn *sqlx.DB, id string) ([]SourceReferenceParams, error) {
-	source, err := ScanSource(conn, id)
+func ListSourceReferences(ctx context.Context, conn *sqlx.DB, id string) ([]SourceReferenceParams, error) {
+	source, err := ScanSource(ctx, conn, id)
 	if err == nil {
-		if err := refreshSourceReferences(conn, source.SourceName.String, source.SchemaName.String, source.DatabaseName.String); err != nil {
+		if err := refreshSourceReferences(ctx, conn, source.SourceName.String, source.SchemaName.String, source.DatabaseName.String); err != nil {
 			return nil, fmt.Errorf("error refreshing source references: %v", err)
 		}
 	}
@@ -81,7 +82,7 @@ func ListSourceReferences(conn *sqlx.DB, id string) ([]SourceReferenceParams, er
 	q := sourceReferenceQuery.QueryPredicate(p)
 
 	var references []SourceReferenceParams
-	if err := conn.Select(&references, q); err != nil {
+	if err := conn.SelectContext(ctx, &references, q); err != nil {
 		return references, err
 	}
 
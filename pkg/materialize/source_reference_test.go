@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -20,7 +21,7 @@ func TestSourceReferenceId(t *testing.T) {
 		).
 			WillReturnRows(sqlmock.NewRows([]string{"source_id"}).AddRow("test-source-id"))
 
-		result, err := SourceReferenceId(db, "test-source-id")
+		result, err := SourceReferenceId(context.Background(), db, "test-source-id")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -43,7 +44,7 @@ func TestScanSourceReference(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"source_id", "namespace", "name", "updated_at", "columns", "source_name", "source_schema_name", "source_database_name", "source_type"}).
 				AddRow("test-source-id", "test-namespace", "test-name", "2024-10-28", StringArray{"col1", "col2"}, "source-name", "source-schema-name", "source-database-name", "source-type"))
 
-		result, err := ScanSourceReference(db, "test-source-id")
+		result, err := ScanSourceReference(context.Background(), db, "test-source-id")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -60,7 +61,7 @@ func TestRefreshSourceReferences(t *testing.T) {
 		).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := refreshSourceReferences(db, "test-source", "test-schema", "test-database")
+		err := refreshSourceReferences(context.Background(), db, "test-source", "test-schema", "test-database")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -80,7 +81,7 @@ func TestListSourceReferences(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"source_id", "namespace", "name", "updated_at", "columns", "source_name", "source_schema_name", "source_database_name", "source_type"}).
 				AddRow("test-source-id", "test-namespace", "test-name", "2024-10-28", StringArray{"col1", "col2"}, "source-name", "source-schema-name", "source-database-name", "source-type"))
 
-		result, err := ListSourceReferences(db, "test-source-id")
+		result, err := ListSourceReferences(context.Background(), db, "test-source-id")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -19,8 +20,8 @@ type SourceSQLServerBuilder struct {
 	awsPrivateLink      IdentifierSchemaStruct
 }
 
-func NewSourceSQLServerBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceSQLServerBuilder {
-	b := Builder{conn, BaseSource}
+func NewSourceSQLServerBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceSQLServerBuilder {
+	b := Builder{ctx, conn, BaseSource}
 	return &SourceSQLServerBuilder{
 		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -20,7 +21,7 @@ func TestSourceSQLServerAllTablesCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 
 		if err := b.Create(); err != nil {
@@ -35,7 +36,7 @@ func TestSourceSQLServerSpecificTablesCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" FOR TABLES \("dbo"."table_1" AS "database"."schema"."s1_table_1", "custom"."table_2" AS "database"."schema"."table_alias"\) EXPOSE PROGRESS AS "database"."schema"."progress";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.Table([]TableStruct{
 			{
@@ -63,7 +64,7 @@ func TestSourceSQLServerWithTextColumnsCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" \(TEXT COLUMNS \(xml_column, ntext_column\)\) FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.TextColumns([]string{"xml_column", "ntext_column"})
 
@@ -79,7 +80,7 @@ func TestSourceSQLServerWithExcludeColumnsCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" \(EXCLUDE COLUMNS \(geometry_column, geography_column\)\) FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.ExcludeColumns([]string{"geometry_column", "geography_column"})
 
@@ -95,7 +96,7 @@ func TestSourceSQLServerWithTextAndExcludeColumnsCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" \(TEXT COLUMNS \(xml_column, ntext_column\), EXCLUDE COLUMNS \(geometry_column, geography_column\)\) FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.TextColumns([]string{"xml_column", "ntext_column"})
 		b.ExcludeColumns([]string{"geometry_column", "geography_column"})
@@ -112,7 +113,7 @@ func TestSourceSQLServerWithClusterCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "test_cluster" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.ClusterName("test_cluster")
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 
@@ -128,7 +129,7 @@ func TestSourceSQLServerDefaultSchemaHandling(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" FOR TABLES \("dbo"."users" AS "database"."schema"."users", "dbo"."orders" AS "database"."schema"."orders"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.Table([]TableStruct{
 			{
@@ -153,7 +154,7 @@ func TestSourceSQLServerWithAWSPrivateLinkCreate(t *testing.T) {
 			`CREATE SOURCE "database"."schema"."source" FROM SQL SERVER CONNECTION "database"."schema"."sqlserver_connection" \(AWS PRIVATELINK "database"."schema"."aws_privatelink_conn"\) FOR ALL TABLES;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		b.SQLServerConnection(IdentifierSchemaStruct{Name: "sqlserver_connection", SchemaName: "schema", DatabaseName: "database"})
 		b.AWSPrivateLink(IdentifierSchemaStruct{Name: "aws_privatelink_conn", SchemaName: "schema", DatabaseName: "database"})
 
@@ -169,7 +170,7 @@ func TestSourceSQLServerAddSubsource(t *testing.T) {
 			`ALTER SOURCE "database"."schema"."source" ADD SUBSOURCE "dbo"."table_1", "dbo"."table_2" AS "database"."schema"."table_alias";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSource(db, sourceSQLServer)
+		b := NewSource(context.Background(), db, sourceSQLServer)
 		if err := b.AddSubsource(tableInputSQLServer, []string{}); err != nil {
 			t.Fatal(err)
 		}
@@ -182,7 +183,7 @@ func TestSourceSQLServerDropSubsource(t *testing.T) {
 			`DROP SOURCE "database"."schema"."table_1", "database"."schema"."table_alias"`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceSQLServerBuilder(db, sourceSQLServer)
+		b := NewSourceSQLServerBuilder(context.Background(), db, sourceSQLServer)
 		if err := b.DropSubsource(tableInputSQLServer); err != nil {
 			t.Fatal(err)
 		}
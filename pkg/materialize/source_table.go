@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -74,7 +75,7 @@ var sourceTableQuery = NewBaseQuery(`
         ON mz_tables.id = comments.id
 `)
 
-func SourceTableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceTableId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -83,18 +84,18 @@ func SourceTableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := sourceTableQuery.QueryPredicate(p)
 
 	var t SourceTableParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return "", err
 	}
 
 	return t.TableId.String, nil
 }
 
-func ScanSourceTable(conn *sqlx.DB, id string) (SourceTableParams, error) {
+func ScanSourceTable(ctx context.Context, conn *sqlx.DB, id string) (SourceTableParams, error) {
 	q := sourceTableQuery.QueryPredicate(map[string]string{"mz_tables.id": id})
 
 	var t SourceTableParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return t, err
 	}
 
@@ -112,9 +113,9 @@ type SourceTableBuilder struct {
 	conn               *sqlx.DB
 }
 
-func NewSourceTableBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableBuilder {
+func NewSourceTableBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceTableBuilder {
 	return &SourceTableBuilder{
-		ddl:          Builder{conn, Table},
+		ddl:          Builder{ctx, conn, Table},
 		tableName:    obj.Name,
 		schemaName:   obj.SchemaName,
 		databaseName: obj.DatabaseName,
@@ -182,7 +183,7 @@ func (b *SourceTableBuilder) BaseCreate(sourceType string, additionalOptions fun
 	return b.ddl.exec(q.String())
 }
 
-func ListSourceTables(conn *sqlx.DB, schemaName, databaseName string) ([]SourceTableParams, error) {
+func ListSourceTables(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]SourceTableParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -190,7 +191,7 @@ func ListSourceTables(conn *sqlx.DB, schemaName, databaseName string) ([]SourceT
 	q := sourceTableQuery.QueryPredicate(p)
 
 	var c []SourceTableParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
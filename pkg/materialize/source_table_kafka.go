@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -49,7 +50,7 @@ var sourceTableKafkaQuery = `
         ON mz_tables.id = comments.id
 `
 
-func SourceTableKafkaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceTableKafkaId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -58,18 +59,18 @@ func SourceTableKafkaId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := NewBaseQuery(sourceTableKafkaQuery).QueryPredicate(p)
 
 	var t SourceTableKafkaParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return "", err
 	}
 
 	return t.TableId.String, nil
 }
 
-func ScanSourceTableKafka(conn *sqlx.DB, id string) (SourceTableKafkaParams, error) {
+func ScanSourceTableKafka(ctx context.Context, conn *sqlx.DB, id string) (SourceTableKafkaParams, error) {
 	q := NewBaseQuery(sourceTableKafkaQuery).QueryPredicate(map[string]string{"mz_tables.id": id})
 
 	var params SourceTableKafkaParams
-	if err := conn.Get(&params, q); err != nil {
+	if err := conn.GetContext(ctx, &params, q); err != nil {
 		return params, err
 	}
 
@@ -95,9 +96,9 @@ type SourceTableKafkaBuilder struct {
 	exposeProgress   IdentifierSchemaStruct
 }
 
-func NewSourceTableKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableKafkaBuilder {
+func NewSourceTableKafkaBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceTableKafkaBuilder {
 	return &SourceTableKafkaBuilder{
-		SourceTableBuilder: NewSourceTableBuilder(conn, obj),
+		SourceTableBuilder: NewSourceTableBuilder(ctx, conn, obj),
 	}
 }
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -21,7 +22,7 @@ func TestResourceSourceTableKafkaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSourceTableKafkaBuilder(db, o)
+		b := NewSourceTableKafkaBuilder(context.Background(), db, o)
 		b.Source(IdentifierSchemaStruct{Name: "kafka_source", DatabaseName: "database", SchemaName: "schema"})
 		b.UpstreamName("topic")
 		b.Format(SourceFormatSpecStruct{Json: true})
@@ -54,7 +55,7 @@ func TestResourceSourceTableKafkaCreateWithAvroFormat(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSourceTableKafkaBuilder(db, o)
+		b := NewSourceTableKafkaBuilder(context.Background(), db, o)
 		b.Source(IdentifierSchemaStruct{Name: "kafka_source", DatabaseName: "database", SchemaName: "schema"})
 		b.UpstreamName("topic")
 		b.Format(SourceFormatSpecStruct{
@@ -86,7 +87,7 @@ func TestResourceSourceTableKafkaCreateWithUpsertOptions(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
-		b := NewSourceTableKafkaBuilder(db, o)
+		b := NewSourceTableKafkaBuilder(context.Background(), db, o)
 		b.Source(IdentifierSchemaStruct{Name: "kafka_source", DatabaseName: "database", SchemaName: "schema"})
 		b.UpstreamName("topic")
 		b.Format(SourceFormatSpecStruct{Json: true})
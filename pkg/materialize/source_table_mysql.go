@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -53,7 +54,7 @@ var sourceTableMySQLQuery = `
 		ON mz_tables.id = comments.id
 `
 
-func SourceTableMySQLId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceTableMySQLId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -62,18 +63,18 @@ func SourceTableMySQLId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := NewBaseQuery(sourceTableMySQLQuery).QueryPredicate(p)
 
 	var t SourceTableParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return "", err
 	}
 
 	return t.TableId.String, nil
 }
 
-func ScanSourceTableMySQL(conn *sqlx.DB, id string) (SourceTableMySQLParams, error) {
+func ScanSourceTableMySQL(ctx context.Context, conn *sqlx.DB, id string) (SourceTableMySQLParams, error) {
 	q := NewBaseQuery(sourceTableMySQLQuery).QueryPredicate(map[string]string{"mz_tables.id": id})
 
 	var params SourceTableMySQLParams
-	if err := conn.Get(&params, q); err != nil {
+	if err := conn.GetContext(ctx, &params, q); err != nil {
 		return params, err
 	}
 
@@ -87,9 +88,9 @@ type SourceTableMySQLBuilder struct {
 	excludeColumns []string
 }
 
-func NewSourceTableMySQLBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableMySQLBuilder {
+func NewSourceTableMySQLBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceTableMySQLBuilder {
 	return &SourceTableMySQLBuilder{
-		SourceTableBuilder: NewSourceTableBuilder(conn, obj),
+		SourceTableBuilder: NewSourceTableBuilder(ctx, conn, obj),
 	}
 }
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -19,7 +20,7 @@ func TestSourceTableCreateWithMySQLSource(t *testing.T) {
 			WITH \(TEXT COLUMNS \("column1", "column2"\), EXCLUDE COLUMNS \("exclude1", "exclude2"\)\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableMySQLBuilder(db, sourceTableMySQL)
+		b := NewSourceTableMySQLBuilder(context.Background(), db, sourceTableMySQL)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 		b.UpstreamSchemaName("upstream_schema")
@@ -38,7 +39,7 @@ func TestSourceTableMySQLRename(t *testing.T) {
 			`ALTER TABLE "database"."schema"."table" RENAME TO "database"."schema"."new_table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableMySQLBuilder(db, sourceTableMySQL)
+		b := NewSourceTableMySQLBuilder(context.Background(), db, sourceTableMySQL)
 		if err := b.Rename("new_table"); err != nil {
 			t.Fatal(err)
 		}
@@ -51,7 +52,7 @@ func TestSourceTableMySQLDrop(t *testing.T) {
 			`DROP TABLE "database"."schema"."table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableMySQLBuilder(db, sourceTableMySQL)
+		b := NewSourceTableMySQLBuilder(context.Background(), db, sourceTableMySQL)
 		if err := b.Drop(); err != nil {
 			t.Fatal(err)
 		}
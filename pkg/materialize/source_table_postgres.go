@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -51,7 +52,7 @@ var sourceTablePostgresQuery = `
 		ON mz_tables.id = comments.id
 `
 
-func SourceTablePostgresId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceTablePostgresId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -60,18 +61,18 @@ func SourceTablePostgresId(conn *sqlx.DB, obj MaterializeObject) (string, error)
 	q := NewBaseQuery(sourceTablePostgresQuery).QueryPredicate(p)
 
 	var t SourceTableParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return "", err
 	}
 
 	return t.TableId.String, nil
 }
 
-func ScanSourceTablePostgres(conn *sqlx.DB, id string) (SourceTablePostgresParams, error) {
+func ScanSourceTablePostgres(ctx context.Context, conn *sqlx.DB, id string) (SourceTablePostgresParams, error) {
 	q := NewBaseQuery(sourceTablePostgresQuery).QueryPredicate(map[string]string{"mz_tables.id": id})
 
 	var params SourceTablePostgresParams
-	if err := conn.Get(&params, q); err != nil {
+	if err := conn.GetContext(ctx, &params, q); err != nil {
 		return params, err
 	}
 
@@ -85,9 +86,9 @@ type SourceTablePostgresBuilder struct {
 	excludeColumns []string
 }
 
-func NewSourceTablePostgresBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTablePostgresBuilder {
+func NewSourceTablePostgresBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceTablePostgresBuilder {
 	return &SourceTablePostgresBuilder{
-		SourceTableBuilder: NewSourceTableBuilder(conn, obj),
+		SourceTableBuilder: NewSourceTableBuilder(ctx, conn, obj),
 	}
 }
 
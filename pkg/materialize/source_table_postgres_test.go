@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -19,7 +20,7 @@ func TestSourceTablePostgresCreate(t *testing.T) {
 			WITH \(TEXT COLUMNS \("column1", "column2"\)\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTablePostgresBuilder(db, sourceTablePostgres)
+		b := NewSourceTablePostgresBuilder(context.Background(), db, sourceTablePostgres)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 		b.UpstreamSchemaName("upstream_schema")
@@ -37,7 +38,7 @@ func TestSourceTablePostgresRename(t *testing.T) {
 			`ALTER TABLE "database"."schema"."table" RENAME TO "database"."schema"."new_table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTablePostgresBuilder(db, sourceTablePostgres)
+		b := NewSourceTablePostgresBuilder(context.Background(), db, sourceTablePostgres)
 		if err := b.Rename("new_table"); err != nil {
 			t.Fatal(err)
 		}
@@ -53,7 +54,7 @@ func TestSourceTablePostgresCreateWithExcludeColumns(t *testing.T) {
 			WITH \(EXCLUDE COLUMNS \("exclude1", "exclude2"\)\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTablePostgresBuilder(db, sourceTablePostgres)
+		b := NewSourceTablePostgresBuilder(context.Background(), db, sourceTablePostgres)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 		b.UpstreamSchemaName("upstream_schema")
@@ -74,7 +75,7 @@ func TestSourceTablePostgresCreateWithTextAndExcludeColumns(t *testing.T) {
 			WITH \(TEXT COLUMNS \("column1", "column2"\), EXCLUDE COLUMNS \("exclude1", "exclude2"\)\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTablePostgresBuilder(db, sourceTablePostgres)
+		b := NewSourceTablePostgresBuilder(context.Background(), db, sourceTablePostgres)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 		b.UpstreamSchemaName("upstream_schema")
@@ -93,7 +94,7 @@ func TestSourceTablePostgresDrop(t *testing.T) {
 			`DROP TABLE "database"."schema"."table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTablePostgresBuilder(db, sourceTablePostgres)
+		b := NewSourceTablePostgresBuilder(context.Background(), db, sourceTablePostgres)
 		if err := b.Drop(); err != nil {
 			t.Fatal(err)
 		}
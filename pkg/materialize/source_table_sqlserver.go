@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -53,7 +54,7 @@ var sourceTableSQLServerQuery = `
 		ON mz_tables.id = comments.id
 `
 
-func SourceTableSQLServerId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceTableSQLServerId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -62,18 +63,18 @@ func SourceTableSQLServerId(conn *sqlx.DB, obj MaterializeObject) (string, error
 	q := NewBaseQuery(sourceTableSQLServerQuery).QueryPredicate(p)
 
 	var t SourceTableParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return "", err
 	}
 
 	return t.TableId.String, nil
 }
 
-func ScanSourceTableSQLServer(conn *sqlx.DB, id string) (SourceTableSQLServerParams, error) {
+func ScanSourceTableSQLServer(ctx context.Context, conn *sqlx.DB, id string) (SourceTableSQLServerParams, error) {
 	q := NewBaseQuery(sourceTableSQLServerQuery).QueryPredicate(map[string]string{"mz_tables.id": id})
 
 	var params SourceTableSQLServerParams
-	if err := conn.Get(&params, q); err != nil {
+	if err := conn.GetContext(ctx, &params, q); err != nil {
 		return params, err
 	}
 
@@ -87,9 +88,9 @@ type SourceTableSQLServerBuilder struct {
 	excludeColumns []ColumnReferenceStruct
 }
 
-func NewSourceTableSQLServerBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableSQLServerBuilder {
+func NewSourceTableSQLServerBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceTableSQLServerBuilder {
 	return &SourceTableSQLServerBuilder{
-		SourceTableBuilder: NewSourceTableBuilder(conn, obj),
+		SourceTableBuilder: NewSourceTableBuilder(ctx, conn, obj),
 	}
 }
 
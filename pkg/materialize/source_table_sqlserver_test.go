@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -10,7 +11,7 @@ import (
 
 func TestSourceTableSQLServerBuilder(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
-		b := NewSourceTableSQLServerBuilder(db, MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"})
+		b := NewSourceTableSQLServerBuilder(context.Background(), db, MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"})
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 		b.UpstreamSchemaName("upstream_schema")
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestSourceTableId(t *testing.T) {
 		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
 		testhelpers.MockSourceTableScan(mock, ip)
 
-		id, err := SourceTableId(db, sourceTable)
+		id, err := SourceTableId(context.Background(), db, sourceTable)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -30,7 +31,7 @@ func TestScanSourceTable(t *testing.T) {
 		pp := `WHERE mz_tables.id = 'u1'`
 		testhelpers.MockSourceTableScan(mock, pp)
 
-		params, err := ScanSourceTable(db, "u1")
+		params, err := ScanSourceTable(context.Background(), db, "u1")
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -44,7 +45,7 @@ func TestScanSourceTable(t *testing.T) {
 }
 
 func TestSourceTableBuilderQualifiedName(t *testing.T) {
-	b := NewSourceTableBuilder(nil, sourceTable)
+	b := NewSourceTableBuilder(context.Background(), nil, sourceTable)
 	expected := `"database"."schema"."table"`
 	if b.QualifiedName() != expected {
 		t.Errorf("Expected qualified name '%s', got '%s'", expected, b.QualifiedName())
@@ -52,7 +53,7 @@ func TestSourceTableBuilderQualifiedName(t *testing.T) {
 }
 
 func TestSourceTableBuilderSource(t *testing.T) {
-	b := NewSourceTableBuilder(nil, sourceTable)
+	b := NewSourceTableBuilder(context.Background(), nil, sourceTable)
 	source := IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"}
 	b.Source(source)
 
@@ -62,7 +63,7 @@ func TestSourceTableBuilderSource(t *testing.T) {
 }
 
 func TestSourceTableBuilderUpstreamName(t *testing.T) {
-	b := NewSourceTableBuilder(nil, sourceTable)
+	b := NewSourceTableBuilder(context.Background(), nil, sourceTable)
 	b.UpstreamName("upstream_table")
 
 	if b.upstreamName != "upstream_table" {
@@ -71,7 +72,7 @@ func TestSourceTableBuilderUpstreamName(t *testing.T) {
 }
 
 func TestSourceTableBuilderUpstreamSchemaName(t *testing.T) {
-	b := NewSourceTableBuilder(nil, sourceTable)
+	b := NewSourceTableBuilder(context.Background(), nil, sourceTable)
 	b.UpstreamSchemaName("upstream_schema")
 
 	if b.upstreamSchemaName != "upstream_schema" {
@@ -85,7 +86,7 @@ func TestSourceTableBuilderRename(t *testing.T) {
 			`ALTER TABLE "database"."schema"."table" RENAME TO "database"."schema"."new_table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableBuilder(db, sourceTable)
+		b := NewSourceTableBuilder(context.Background(), db, sourceTable)
 		if err := b.Rename("new_table"); err != nil {
 			t.Fatal(err)
 		}
@@ -98,7 +99,7 @@ func TestSourceTableBuilderDrop(t *testing.T) {
 			`DROP TABLE "database"."schema"."table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableBuilder(db, sourceTable)
+		b := NewSourceTableBuilder(context.Background(), db, sourceTable)
 		if err := b.Drop(); err != nil {
 			t.Fatal(err)
 		}
@@ -111,7 +112,7 @@ func TestSourceTableBuilderBaseCreate(t *testing.T) {
 			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source" \(REFERENCE "upstream_schema"."upstream_table"\) WITH \(TEXT COLUMNS \("col1", "col2"\)\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableBuilder(db, sourceTable)
+		b := NewSourceTableBuilder(context.Background(), db, sourceTable)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 		b.UpstreamSchemaName("upstream_schema")
@@ -131,7 +132,7 @@ func TestSourceTableBuilderBaseCreateWithoutReference(t *testing.T) {
 			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source" WITH \(TEXT COLUMNS \("col1"\)\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableBuilder(db, sourceTable)
+		b := NewSourceTableBuilder(context.Background(), db, sourceTable)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 
 		err := b.BaseCreate("kafka", func() string {
@@ -149,7 +150,7 @@ func TestSourceTableBuilderBaseCreateWithoutOptions(t *testing.T) {
 			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source" \(REFERENCE "upstream_table"\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableBuilder(db, sourceTable)
+		b := NewSourceTableBuilder(context.Background(), db, sourceTable)
 		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "public", DatabaseName: "materialize"})
 		b.UpstreamName("upstream_table")
 
@@ -165,7 +166,7 @@ func TestListSourceTables(t *testing.T) {
 		predicate := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
 		testhelpers.MockSourceTableScan(mock, predicate)
 
-		tables, err := ListSourceTables(db, "schema", "database")
+		tables, err := ListSourceTables(context.Background(), db, "schema", "database")
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -38,7 +39,7 @@ var sourceTableWebhookQuery = NewBaseQuery(`
 		ON mz_tables.id = comments.id`)
 
 // SourceTableWebhookId retrieves the ID of a webhook source table
-func SourceTableWebhookId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func SourceTableWebhookId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -47,7 +48,7 @@ func SourceTableWebhookId(conn *sqlx.DB, obj MaterializeObject) (string, error)
 	q := sourceTableWebhookQuery.QueryPredicate(p)
 
 	var t SourceTableParams
-	if err := conn.Get(&t, q); err != nil {
+	if err := conn.GetContext(ctx, &t, q); err != nil {
 		return "", err
 	}
 
@@ -55,11 +56,11 @@ func SourceTableWebhookId(conn *sqlx.DB, obj MaterializeObject) (string, error)
 }
 
 // ScanSourceTableWebhook scans a webhook source table by ID
-func ScanSourceTableWebhook(conn *sqlx.DB, id string) (SourceTableWebhookParams, error) {
+func ScanSourceTableWebhook(ctx context.Context, conn *sqlx.DB, id string) (SourceTableWebhookParams, error) {
 	q := sourceTableWebhookQuery.QueryPredicate(map[string]string{"mz_tables.id": id})
 
 	var params SourceTableWebhookParams
-	if err := conn.Get(&params, q); err != nil {
+	if err := conn.GetContext(ctx, &params, q); err != nil {
 		return params, err
 	}
 
@@ -80,9 +81,9 @@ type SourceTableWebhookBuilder struct {
 }
 
 // NewSourceTableWebhookBuilder creates a new webhook source table builder
-func NewSourceTableWebhookBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableWebhookBuilder {
+func NewSourceTableWebhookBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceTableWebhookBuilder {
 	return &SourceTableWebhookBuilder{
-		ddl:          Builder{conn, Table},
+		ddl:          Builder{ctx, conn, Table},
 		tableName:    obj.Name,
 		schemaName:   obj.SchemaName,
 		databaseName: obj.DatabaseName,
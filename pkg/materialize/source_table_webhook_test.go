@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -29,7 +30,7 @@ func TestSourceTableWebhookCreateExposeHeaders(t *testing.T) {
 			},
 		}
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		b.BodyFormat("JSON")
 		b.IncludeHeader(includeHeader)
 
@@ -46,7 +47,7 @@ func TestSourceTableWebhookCreateIncludeHeaders(t *testing.T) {
 			FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADERS \(NOT 'authorization', NOT 'x-api-key'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		b.BodyFormat("JSON")
 		b.IncludeHeaders(IncludeHeadersStruct{
 			Not: []string{"authorization", "x-api-key"},
@@ -86,7 +87,7 @@ func TestSourceTableWebhookCreateValidated(t *testing.T) {
 			},
 		}
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		b.BodyFormat("JSON")
 		b.CheckOptions(checkOptions)
 		b.CheckExpression("decode(headers->'x-signature', 'base64') = hmac(request_body, my_webhook_shared_secret, 'sha256')")
@@ -133,7 +134,7 @@ func TestSourceTableWebhookCreateSegment(t *testing.T) {
 			},
 		}
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		b.BodyFormat("JSON")
 		b.IncludeHeader(includeHeader)
 		b.IncludeHeaders(IncludeHeadersStruct{All: true})
@@ -171,7 +172,7 @@ func TestSourceTableWebhookCreateRudderstack(t *testing.T) {
 			},
 		}
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		b.BodyFormat("JSON")
 		b.CheckOptions(checkOptions)
 		b.CheckExpression("headers->'authorization' = rudderstack_shared_secret")
@@ -188,7 +189,7 @@ func TestSourceTableWebhookRename(t *testing.T) {
 			`ALTER TABLE "database"."schema"."webhook_table" RENAME TO "database"."schema"."new_webhook_table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		if err := b.Rename("new_webhook_table"); err != nil {
 			t.Fatal(err)
 		}
@@ -201,7 +202,7 @@ func TestSourceTableWebhookDrop(t *testing.T) {
 			`DROP TABLE "database"."schema"."webhook_table";`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceTableWebhookBuilder(db, sourceTableWebhook)
+		b := NewSourceTableWebhookBuilder(context.Background(), db, sourceTableWebhook)
 		if err := b.Drop(); err != nil {
 			t.Fatal(err)
 		}
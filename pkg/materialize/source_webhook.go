@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -42,8 +43,8 @@ type SourceWebhookBuilder struct {
 	checkExpression string
 }
 
-func NewSourceWebhookBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceWebhookBuilder {
-	b := Builder{conn, BaseSource}
+func NewSourceWebhookBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *SourceWebhookBuilder {
+	b := Builder{ctx, conn, BaseSource}
 	return &SourceWebhookBuilder{
 		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
 	}
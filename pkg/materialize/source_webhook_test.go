@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -30,7 +31,7 @@ func TestSourceWebhookCreateExposeHeaders(t *testing.T) {
 			},
 		}
 
-		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b := NewSourceWebhookBuilder(context.Background(), db, sourceWebhook)
 		b.ClusterName("cluster")
 		b.BodyFormat("JSON")
 		b.IncludeHeader(includeHeader)
@@ -49,7 +50,7 @@ func TestSourceWebhookCreateIncludeHeaders(t *testing.T) {
 			FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADERS \(NOT 'authorization', NOT 'x-api-key'\);`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b := NewSourceWebhookBuilder(context.Background(), db, sourceWebhook)
 		b.ClusterName("cluster")
 		b.BodyFormat("JSON")
 		b.IncludeHeaders(IncludeHeadersStruct{
@@ -91,7 +92,7 @@ func TestSourceWebhookCreateValidated(t *testing.T) {
 			},
 		}
 
-		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b := NewSourceWebhookBuilder(context.Background(), db, sourceWebhook)
 		b.ClusterName("cluster")
 		b.BodyFormat("JSON")
 		b.CheckOptions(checkOptions)
@@ -140,7 +141,7 @@ func TestSourceWebhookCreateSegment(t *testing.T) {
 			},
 		}
 
-		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b := NewSourceWebhookBuilder(context.Background(), db, sourceWebhook)
 		b.ClusterName("cluster")
 		b.BodyFormat("JSON")
 		b.IncludeHeader(includeHeader)
@@ -179,7 +180,7 @@ func TestSourceWebhookCreateRudderstack(t *testing.T) {
 			},
 		}
 
-		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b := NewSourceWebhookBuilder(context.Background(), db, sourceWebhook)
 		b.ClusterName("cluster")
 		b.BodyFormat("JSON")
 		b.CheckOptions(checkOptions)
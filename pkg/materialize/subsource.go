@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/jmoiron/sqlx"
@@ -86,7 +87,7 @@ var sqlserverSubsourceQuery = NewBaseQuery(`
 		ON mz_sources.id = mz_sql_server_source_tables.id
 `)
 
-func ListPostgresSubsources(conn *sqlx.DB, sourceId string, objectType string) ([]SubsourceDetail, error) {
+func ListPostgresSubsources(ctx context.Context, conn *sqlx.DB, sourceId string, objectType string) ([]SubsourceDetail, error) {
 	p := map[string]string{
 		"mz_object_dependencies.referenced_object_id": sourceId,
 	}
@@ -98,13 +99,13 @@ func ListPostgresSubsources(conn *sqlx.DB, sourceId string, objectType string) (
 	q := postgresSubsourceQuery.QueryPredicate(p)
 
 	var subsources []SubsourceDetail
-	if err := conn.Select(&subsources, q); err != nil {
+	if err := conn.SelectContext(ctx, &subsources, q); err != nil {
 		return nil, err
 	}
 	return subsources, nil
 }
 
-func ListMysqlSubsources(conn *sqlx.DB, sourceId string, objectType string) ([]SubsourceDetail, error) {
+func ListMysqlSubsources(ctx context.Context, conn *sqlx.DB, sourceId string, objectType string) ([]SubsourceDetail, error) {
 	p := map[string]string{
 		"mz_object_dependencies.referenced_object_id": sourceId,
 	}
@@ -116,13 +117,13 @@ func ListMysqlSubsources(conn *sqlx.DB, sourceId string, objectType string) ([]S
 	q := mysqlSubsourceQuery.QueryPredicate(p)
 
 	var subsources []SubsourceDetail
-	if err := conn.Select(&subsources, q); err != nil {
+	if err := conn.SelectContext(ctx, &subsources, q); err != nil {
 		return nil, err
 	}
 	return subsources, nil
 }
 
-func ListSQLServerSubsources(conn *sqlx.DB, sourceId string, objectType string) ([]SubsourceDetail, error) {
+func ListSQLServerSubsources(ctx context.Context, conn *sqlx.DB, sourceId string, objectType string) ([]SubsourceDetail, error) {
 	p := map[string]string{
 		"mz_object_dependencies.referenced_object_id": sourceId,
 	}
@@ -134,7 +135,7 @@ func ListSQLServerSubsources(conn *sqlx.DB, sourceId string, objectType string)
 	q := sqlserverSubsourceQuery.QueryPredicate(p)
 
 	var subsources []SubsourceDetail
-	if err := conn.Select(&subsources, q); err != nil {
+	if err := conn.SelectContext(ctx, &subsources, q); err != nil {
 		return nil, err
 	}
 	return subsources, nil
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -12,9 +13,9 @@ type SystemParameterBuilder struct {
 	paramValue string
 }
 
-func NewSystemParameterBuilder(conn *sqlx.DB, paramName, paramValue string) *SystemParameterBuilder {
+func NewSystemParameterBuilder(ctx context.Context, conn *sqlx.DB, paramName, paramValue string) *SystemParameterBuilder {
 	return &SystemParameterBuilder{
-		ddl:        Builder{conn, System},
+		ddl:        Builder{ctx, conn, System},
 		paramName:  paramName,
 		paramValue: paramValue,
 	}
@@ -30,10 +31,10 @@ func (b *SystemParameterBuilder) Reset() error {
 	return b.ddl.exec(q)
 }
 
-func ShowSystemParameter(conn *sqlx.DB, paramName string) (string, error) {
+func ShowSystemParameter(ctx context.Context, conn *sqlx.DB, paramName string) (string, error) {
 	var paramValue string
 	query := fmt.Sprintf(`SHOW %s;`, QuoteIdentifier(paramName))
-	err := conn.QueryRow(query).Scan(&paramValue)
+	err := conn.QueryRowContext(ctx, query).Scan(&paramValue)
 	if err != nil {
 		return "", fmt.Errorf("error reading system parameter %s: %v", paramName, err)
 	}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -15,7 +16,7 @@ func TestSystemParameterSet(t *testing.T) {
 
 		mock.ExpectExec(`ALTER SYSTEM SET "` + paramName + `" TO '` + paramValue + `';`).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		spBuilder := NewSystemParameterBuilder(db, paramName, paramValue)
+		spBuilder := NewSystemParameterBuilder(context.Background(), db, paramName, paramValue)
 		if err := spBuilder.Set(); err != nil {
 			t.Fatalf("unexpected error during Set: %v", err)
 		}
@@ -36,7 +37,7 @@ func TestSystemParameterReset(t *testing.T) {
 		mock.ExpectExec(`ALTER SYSTEM RESET "` + paramName + `";`).WillReturnResult(sqlmock.NewResult(0, 1))
 
 		// Create a new SystemParameterBuilder and attempt to reset the system parameter
-		spBuilder := NewSystemParameterBuilder(db, paramName, "")
+		spBuilder := NewSystemParameterBuilder(context.Background(), db, paramName, "")
 		if err := spBuilder.Reset(); err != nil {
 			t.Fatalf("unexpected error during Reset: %v", err)
 		}
@@ -58,7 +59,7 @@ func TestShowSystemParameter(t *testing.T) {
 		mock.ExpectQuery(`SHOW "` + paramName + `";`).WillReturnRows(sqlmock.NewRows([]string{"Value"}).AddRow(expectedValue))
 
 		// Attempt to retrieve the system parameter value
-		value, err := ShowSystemParameter(db, paramName)
+		value, err := ShowSystemParameter(context.Background(), db, paramName)
 		if err != nil {
 			t.Fatalf("unexpected error during ShowSystemParameter: %v", err)
 		}
@@ -81,7 +82,7 @@ func TestSystemParameterSetQuotesValue(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER SYSTEM SET "cluster" TO 'it''s';`).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		if err := NewSystemParameterBuilder(db, "cluster", "it's").Set(); err != nil {
+		if err := NewSystemParameterBuilder(context.Background(), db, "cluster", "it's").Set(); err != nil {
 			t.Fatalf("unexpected error during Set: %v", err)
 		}
 
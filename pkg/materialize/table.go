@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -41,9 +42,9 @@ type TableBuilder struct {
 	column       []TableColumn
 }
 
-func NewTableBuilder(conn *sqlx.DB, obj MaterializeObject) *TableBuilder {
+func NewTableBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *TableBuilder {
 	return &TableBuilder{
-		ddl:          Builder{conn, Table},
+		ddl:          Builder{ctx, conn, Table},
 		tableName:    obj.Name,
 		schemaName:   obj.SchemaName,
 		databaseName: obj.DatabaseName,
@@ -127,7 +128,7 @@ var tableQuery = NewBaseQuery(`
 	) comments
 		ON mz_tables.id = comments.id`)
 
-func TableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func TableId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_tables.name":    obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -136,28 +137,28 @@ func TableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := tableQuery.QueryPredicate(p)
 
 	var c TableParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.TableId.String, nil
 }
 
-func ScanTable(conn *sqlx.DB, id string) (TableParams, error) {
+func ScanTable(ctx context.Context, conn *sqlx.DB, id string) (TableParams, error) {
 	p := map[string]string{
 		"mz_tables.id": id,
 	}
 	q := tableQuery.QueryPredicate(p)
 
 	var c TableParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListTables(conn *sqlx.DB, schemaName, databaseName string) ([]TableParams, error) {
+func ListTables(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]TableParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -165,7 +166,7 @@ func ListTables(conn *sqlx.DB, schemaName, databaseName string) ([]TableParams,
 	q := tableQuery.QueryPredicate(p)
 
 	var c []TableParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -19,7 +20,7 @@ func TestTableCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
-		b := NewTableBuilder(db, o)
+		b := NewTableBuilder(context.Background(), db, o)
 		b.Column([]TableColumn{
 			{
 				ColName: "a",
@@ -60,7 +61,7 @@ func TestTableRename(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
-		if err := NewTableBuilder(db, o).Rename("new_table"); err != nil {
+		if err := NewTableBuilder(context.Background(), db, o).Rename("new_table"); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -73,7 +74,7 @@ func TestTableDrop(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
-		if err := NewTableBuilder(db, o).Drop(); err != nil {
+		if err := NewTableBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -0,0 +1,147 @@
+package materialize
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrTransactionNotSupported is returned by InTransaction when Materialize
+// refused to run one of the statements in a transaction block. Nothing was
+// applied and the statements can be run again without a transaction.
+var ErrTransactionNotSupported = errors.New("statements cannot run in a transaction")
+
+// InTransaction runs fn in a transaction that is committed if fn succeeds and
+// rolled back otherwise. The handle passed to fn runs every statement in the
+// transaction, so builders created with it need no changes. Errors starting
+// the transaction are returned as they are.
+func InTransaction(ctx context.Context, conn *sqlx.DB, fn func(tx *sqlx.DB) error) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %w", err)
+	}
+
+	txDb := sqlx.NewDb(sql.OpenDB(&txConnector{tx: tx}), conn.DriverName())
+	defer txDb.Close()
+
+	if err := fn(txDb); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			log.Printf("[DEBUG] unable to roll back transaction: %s", rbErr)
+		}
+		if isTransactionRejected(err) {
+			return fmt.Errorf("%w: %s", ErrTransactionNotSupported, err)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// isTransactionRejected reports whether err is Materialize refusing to run a
+// statement in a transaction block, rather than the statement itself failing.
+// Other errors, such as those of statements Materialize does not support at
+// all, must not be retried outside of the transaction.
+func isTransactionRejected(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	// active_sql_transaction
+	return pgErr.SQLState() == "25001" || strings.Contains(pgErr.Message, "cannot be run inside a transaction block")
+}
+
+// txConnector hands out connections that run their statements in tx.
+type txConnector struct {
+	tx *sqlx.Tx
+}
+
+func (c *txConnector) Connect(context.Context) (driver.Conn, error) {
+	return &txConn{tx: c.tx}, nil
+}
+
+func (c *txConnector) Driver() driver.Driver { return txDriver{} }
+
+type txDriver struct{}
+
+func (txDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("transaction connections cannot be opened by name")
+}
+
+type txConn struct {
+	tx *sqlx.Tx
+}
+
+func (c *txConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported in transactions")
+}
+
+func (c *txConn) Close() error { return nil }
+
+func (c *txConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("already in a transaction")
+}
+
+func (c *txConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.tx.ExecContext(ctx, query, namedValues(args)...)
+}
+
+func (c *txConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.tx.QueryContext(ctx, query, namedValues(args)...)
+	if err != nil {
+		return nil, err
+	}
+	return &txRows{rows: rows}, nil
+}
+
+func namedValues(args []driver.NamedValue) []interface{} {
+	values := make([]interface{}, len(args))
+	for i, a := range args {
+		if a.Name != "" {
+			values[i] = sql.Named(a.Name, a.Value)
+		} else {
+			values[i] = a.Value
+		}
+	}
+	return values
+}
+
+type txRows struct {
+	rows *sql.Rows
+}
+
+func (r *txRows) Columns() []string {
+	columns, _ := r.rows.Columns()
+	return columns
+}
+
+func (r *txRows) Close() error { return r.rows.Close() }
+
+func (r *txRows) Next(dest []driver.Value) error {
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	values := make([]interface{}, len(dest))
+	pointers := make([]interface{}, len(dest))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	if err := r.rows.Scan(pointers...); err != nil {
+		return err
+	}
+	for i, v := range values {
+		dest[i] = v
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package materialize
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInTransaction(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE VIEW "database"."schema"."view" AS SELECT 1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`SELECT mz_views.id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("u1"))
+		mock.ExpectCommit()
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		err := InTransaction(context.Background(), db, func(tx *sqlx.DB) error {
+			if err := NewViewBuilder(context.Background(), tx, o).SelectStmt("SELECT 1").Create(); err != nil {
+				return err
+			}
+
+			// Queries see the objects created in the transaction
+			id, err := ViewId(context.Background(), tx, o)
+			r.Equal("u1", id)
+			return err
+		})
+		r.NoError(err)
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestInTransactionRejected(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE CLUSTER "cluster"`).WillReturnError(&pgconn.PgError{
+			Severity: "ERROR",
+			Code:     "25001",
+			Message:  "CREATE CLUSTER cannot be run inside a transaction block",
+		})
+		mock.ExpectRollback()
+
+		o := MaterializeObject{Name: "cluster"}
+		err := InTransaction(context.Background(), db, func(tx *sqlx.DB) error {
+			return NewClusterBuilder(context.Background(), tx, o).Create()
+		})
+		r.ErrorIs(err, ErrTransactionNotSupported)
+		r.ErrorContains(err, "cannot be run inside a transaction block")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestInTransactionFailure(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE VIEW`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" OWNER TO "joe";`).WillReturnError(&pgconn.PgError{
+			Severity: "ERROR",
+			Code:     "42704",
+			Message:  `unknown role 'joe'`,
+		})
+		mock.ExpectRollback()
+
+		o := MaterializeObject{ObjectType: View, Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		err := InTransaction(context.Background(), db, func(tx *sqlx.DB) error {
+			if err := NewViewBuilder(context.Background(), tx, o).SelectStmt("SELECT 1").Create(); err != nil {
+				return err
+			}
+			return NewOwnershipBuilder(context.Background(), tx, o).Alter("joe")
+		})
+		r.Error(err)
+		r.False(errors.Is(err, ErrTransactionNotSupported))
+		r.Equal(`ERROR: unknown role 'joe' (SQLSTATE 42704)`, err.Error())
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestInTransactionBeginFailure(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		beginErr := errors.New("connection refused")
+		mock.ExpectBegin().WillReturnError(beginErr)
+
+		err := InTransaction(context.Background(), db, func(tx *sqlx.DB) error {
+			t.Fatal("statements must not run without a transaction")
+			return nil
+		})
+		// Connection errors must not be taken for a rejected transaction
+		r.ErrorIs(err, beginErr)
+		r.False(errors.Is(err, ErrTransactionNotSupported))
+	})
+}
+
+func TestInTransactionUnsupportedStatement(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE VIEW`).WillReturnError(&pgconn.PgError{
+			Severity: "ERROR",
+			Code:     "0A000",
+			Message:  "WITH MUTUALLY RECURSIVE is not supported",
+		})
+		mock.ExpectRollback()
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		err := InTransaction(context.Background(), db, func(tx *sqlx.DB) error {
+			return NewViewBuilder(context.Background(), tx, o).SelectStmt("SELECT 1").Create()
+		})
+		r.Error(err)
+		r.False(errors.Is(err, ErrTransactionNotSupported))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
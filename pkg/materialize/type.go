@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -67,9 +68,9 @@ type Type struct {
 	mapProperties  []MapProperties
 }
 
-func NewTypeBuilder(conn *sqlx.DB, obj MaterializeObject) *Type {
+func NewTypeBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *Type {
 	return &Type{
-		ddl:          Builder{conn, BaseType},
+		ddl:          Builder{ctx, conn, BaseType},
 		typeName:     obj.Name,
 		schemaName:   obj.SchemaName,
 		databaseName: obj.DatabaseName,
@@ -171,7 +172,7 @@ var typeQuery = NewBaseQuery(`
 	) comments
 		ON mz_types.id = comments.id`)
 
-func TypeId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func TypeId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_types.name":     obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -180,28 +181,28 @@ func TypeId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := typeQuery.QueryPredicate(p)
 
 	var c TypeParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.TypeId.String, nil
 }
 
-func ScanType(conn *sqlx.DB, id string) (TypeParams, error) {
+func ScanType(ctx context.Context, conn *sqlx.DB, id string) (TypeParams, error) {
 	p := map[string]string{
 		"mz_types.id": id,
 	}
 	q := typeQuery.QueryPredicate(p)
 
 	var c TypeParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListTypes(conn *sqlx.DB, schemaName, databaseName string) ([]TypeParams, error) {
+func ListTypes(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]TypeParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -209,7 +210,7 @@ func ListTypes(conn *sqlx.DB, schemaName, databaseName string) ([]TypeParams, er
 	q := typeQuery.QueryPredicate(p)
 
 	var c []TypeParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -17,7 +18,7 @@ func TestTypeCustomListCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
-		b := NewTypeBuilder(db, o)
+		b := NewTypeBuilder(context.Background(), db, o)
 		b.ListProperties([]ListProperties{
 			{
 				ElementType: "int4",
@@ -37,7 +38,7 @@ func TestTypeCustomMapCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
-		b := NewTypeBuilder(db, o)
+		b := NewTypeBuilder(context.Background(), db, o)
 		b.MapProperties([]MapProperties{
 			{
 				KeyType:   "text",
@@ -58,7 +59,7 @@ func TestTypeCustomRowCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
-		b := NewTypeBuilder(db, o)
+		b := NewTypeBuilder(context.Background(), db, o)
 		b.RowProperties([]RowProperties{
 			{
 				FieldName: "a",
@@ -83,7 +84,7 @@ func TestTypeDrop(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
-		if err := NewTypeBuilder(db, o).Drop(); err != nil {
+		if err := NewTypeBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
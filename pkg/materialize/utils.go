@@ -46,6 +46,67 @@ func QualifiedName(fields ...string) string {
 	return q
 }
 
+// ParseQualifiedName splits a dot separated name into its identifiers. It
+// accepts the output of QualifiedName and follows the same rules as SQL:
+// quoted identifiers keep their case and use "" to escape a quote, unquoted
+// identifiers are folded to lower case.
+func ParseQualifiedName(name string) ([]string, error) {
+	var fields []string
+	r := []rune(name)
+
+	for i := 0; i <= len(r); i++ {
+		var f strings.Builder
+		if i < len(r) && r[i] == '"' {
+			for i++; ; i++ {
+				if i >= len(r) {
+					return nil, fmt.Errorf("unterminated quoted identifier in %s", name)
+				}
+				if r[i] == '"' {
+					if i+1 < len(r) && r[i+1] == '"' {
+						i++
+					} else {
+						i++
+						break
+					}
+				}
+				f.WriteRune(r[i])
+			}
+			if i < len(r) && r[i] != '.' {
+				return nil, fmt.Errorf("unexpected character after quoted identifier in %s", name)
+			}
+			if f.Len() == 0 {
+				return nil, fmt.Errorf("empty identifier in %s", name)
+			}
+			fields = append(fields, f.String())
+			continue
+		}
+
+		for ; i < len(r) && r[i] != '.'; i++ {
+			if r[i] == '"' {
+				return nil, fmt.Errorf("unexpected quote in identifier in %s", name)
+			}
+			f.WriteRune(r[i])
+		}
+		if f.Len() == 0 {
+			return nil, fmt.Errorf("empty identifier in %s", name)
+		}
+		fields = append(fields, strings.ToLower(f.String()))
+	}
+
+	return fields, nil
+}
+
+// ParseNameId splits the name of a name based state ID into its database,
+// schema and object names. Names are either parsed with ParseQualifiedName or,
+// if they are unquoted and contain a |, use the `database|schema` form stored
+// by materialize_schema and are split into at most n names as is.
+func ParseNameId(name string, n int) ([]string, error) {
+	if !strings.HasPrefix(name, `"`) && strings.Contains(name, "|") {
+		return strings.SplitN(name, "|", n), nil
+	}
+	return ParseQualifiedName(name)
+}
+
 func GetSliceValueString(attrName string, v []interface{}) ([]string, error) {
 	var o []string
 	for _, item := range v {
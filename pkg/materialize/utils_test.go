@@ -15,3 +15,44 @@ func TestQualifiedName(t *testing.T) {
 	qs := QualifiedName("database", "schema")
 	rs.Equal(qs, `"database"."schema"`)
 }
+
+func TestParseQualifiedName(t *testing.T) {
+	r := require.New(t)
+
+	f, err := ParseQualifiedName(`database.schema.resource`)
+	r.NoError(err)
+	r.Equal([]string{"database", "schema", "resource"}, f)
+
+	f, err = ParseQualifiedName(QualifiedName("My.Database", `sch"ema`, "resource"))
+	r.NoError(err)
+	r.Equal([]string{"My.Database", `sch"ema`, "resource"}, f)
+
+	f, err = ParseQualifiedName(`Materialize."Public".Resource`)
+	r.NoError(err)
+	r.Equal([]string{"materialize", "Public", "resource"}, f)
+
+	for _, n := range []string{``, `database..resource`, `database.schema.`, `"database`, `"database"x.schema`, `data"base.schema`, `""`} {
+		_, err := ParseQualifiedName(n)
+		r.Error(err, n)
+	}
+}
+
+func TestParseNameId(t *testing.T) {
+	r := require.New(t)
+
+	f, err := ParseNameId(`Database|Schema`, 2)
+	r.NoError(err)
+	r.Equal([]string{"Database", "Schema"}, f)
+
+	f, err = ParseNameId(QualifiedName("Database", "Schema"), 2)
+	r.NoError(err)
+	r.Equal([]string{"Database", "Schema"}, f)
+
+	f, err = ParseNameId(QualifiedName("a|b", "schema", "view"), 3)
+	r.NoError(err)
+	r.Equal([]string{"a|b", "schema", "view"}, f)
+
+	f, err = ParseNameId(`database.schema.view`, 3)
+	r.NoError(err)
+	r.Equal([]string{"database", "schema", "view"}, f)
+}
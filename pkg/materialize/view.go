@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -17,9 +18,9 @@ type ViewBuilder struct {
 	selectStmt   string
 }
 
-func NewViewBuilder(conn *sqlx.DB, obj MaterializeObject) *ViewBuilder {
+func NewViewBuilder(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) *ViewBuilder {
 	return &ViewBuilder{
-		ddl:          Builder{conn, View},
+		ddl:          Builder{ctx, conn, View},
 		viewName:     obj.Name,
 		schemaName:   obj.SchemaName,
 		databaseName: obj.DatabaseName,
@@ -87,7 +88,7 @@ var viewQuery = NewBaseQuery(`
 	) comments
 		ON mz_views.id = comments.id`)
 
-func ViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+func ViewId(ctx context.Context, conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
 		"mz_views.name":     obj.Name,
 		"mz_schemas.name":   obj.SchemaName,
@@ -96,28 +97,28 @@ func ViewId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	q := viewQuery.QueryPredicate(p)
 
 	var c ViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return "", err
 	}
 
 	return c.ViewId.String, nil
 }
 
-func ScanView(conn *sqlx.DB, id string) (ViewParams, error) {
+func ScanView(ctx context.Context, conn *sqlx.DB, id string) (ViewParams, error) {
 	p := map[string]string{
 		"mz_views.id": id,
 	}
 	q := viewQuery.QueryPredicate(p)
 
 	var c ViewParams
-	if err := conn.Get(&c, q); err != nil {
+	if err := conn.GetContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
 	return c, nil
 }
 
-func ListViews(conn *sqlx.DB, schemaName, databaseName string) ([]ViewParams, error) {
+func ListViews(ctx context.Context, conn *sqlx.DB, schemaName, databaseName string) ([]ViewParams, error) {
 	p := map[string]string{
 		"mz_schemas.name":   schemaName,
 		"mz_databases.name": databaseName,
@@ -125,7 +126,7 @@ func ListViews(conn *sqlx.DB, schemaName, databaseName string) ([]ViewParams, er
 	q := viewQuery.QueryPredicate(p)
 
 	var c []ViewParams
-	if err := conn.Select(&c, q); err != nil {
+	if err := conn.SelectContext(ctx, &c, q); err != nil {
 		return c, err
 	}
 
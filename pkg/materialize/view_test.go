@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -18,7 +19,7 @@ func TestViewCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
-		b := NewViewBuilder(db, o)
+		b := NewViewBuilder(context.Background(), db, o)
 		b.SelectStmt("SELECT 1 FROM t1")
 
 		if err := b.Create(); err != nil {
@@ -34,7 +35,7 @@ func TestViewRename(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
-		if err := NewViewBuilder(db, o).Rename("new_view"); err != nil {
+		if err := NewViewBuilder(context.Background(), db, o).Rename("new_view"); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -47,7 +48,7 @@ func TestViewDrop(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
-		if err := NewViewBuilder(db, o).Drop(); err != nil {
+		if err := NewViewBuilder(context.Background(), db, o).Drop(); err != nil {
 			t.Fatal(err)
 		}
 	})
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -141,7 +142,7 @@ func testAccCheckClusterReplicaExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("cluster replica not found: %s", name)
 		}
-		_, err = materialize.ScanClusterReplica(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanClusterReplica(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -158,7 +159,7 @@ func testAccCheckAllClusterReplicaDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanClusterReplica(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanClusterReplica(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("Cluster replica %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -614,7 +615,7 @@ func testAccCheckClusterExists(name string) resource.TestCheckFunc {
 		if r.Primary.Attributes["identify_by_name"] == "true" {
 			identifyByName = true
 		}
-		_, err = materialize.ScanCluster(db, utils.ExtractId(r.Primary.ID), identifyByName)
+		_, err = materialize.ScanCluster(context.Background(), db, utils.ExtractId(r.Primary.ID), identifyByName)
 		return err
 	}
 }
@@ -631,7 +632,7 @@ func testAccCheckAllClusterDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanCluster(db, utils.ExtractId(r.Primary.ID), false)
+		_, err := materialize.ScanCluster(context.Background(), db, utils.ExtractId(r.Primary.ID), false)
 		if err == nil {
 			return fmt.Errorf("Cluster %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -202,7 +203,7 @@ func testAccCheckConnectionAwsExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("error getting DB client: %s", err)
 		}
 
-		_, err = materialize.ScanConnectionAws(db, utils.ExtractId(rs.Primary.ID))
+		_, err = materialize.ScanConnectionAws(context.Background(), db, utils.ExtractId(rs.Primary.ID))
 		if err != nil {
 			return fmt.Errorf("Connection AWS (%s) not found", rs.Primary.ID)
 		}
@@ -223,7 +224,7 @@ func testAccCheckConnectionAwsDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnectionAws(db, utils.ExtractId(rs.Primary.ID))
+		_, err := materialize.ScanConnectionAws(context.Background(), db, utils.ExtractId(rs.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("Connection AWS %s still exists", rs.Primary.ID)
 		}
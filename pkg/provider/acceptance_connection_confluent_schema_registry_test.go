@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -232,7 +233,7 @@ func testAccCheckConnConfluentSchemaRegistryExists(name string) resource.TestChe
 		if !ok {
 			return fmt.Errorf("connection confluent schema registry not found: %s", name)
 		}
-		_, err = materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -249,7 +250,7 @@ func testAccCheckAllConnConfluentSchemaRegistryDestroyed(s *terraform.State) err
 			continue
 		}
 
-		_, err := materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
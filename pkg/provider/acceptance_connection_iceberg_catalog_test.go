@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -156,7 +157,7 @@ func testAccCheckConnectionIcebergCatalogExists(resourceName string) resource.Te
 			return fmt.Errorf("error getting DB client: %s", err)
 		}
 
-		_, err = materialize.ScanConnection(db, utils.ExtractId(rs.Primary.ID))
+		_, err = materialize.ScanConnection(context.Background(), db, utils.ExtractId(rs.Primary.ID))
 		if err != nil {
 			return fmt.Errorf("connection Iceberg Catalog (%s) not found", rs.Primary.ID)
 		}
@@ -202,7 +203,7 @@ func testAccCheckConnectionIcebergCatalogDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnection(db, utils.ExtractId(rs.Primary.ID))
+		_, err := materialize.ScanConnection(context.Background(), db, utils.ExtractId(rs.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection Iceberg Catalog %s still exists", rs.Primary.ID)
 		}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -759,7 +760,7 @@ func testAccCheckConnKafkaExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("connection kafka not found: %s", name)
 		}
-		_, err = materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -776,7 +777,7 @@ func testAccCheckAllConnKafkaDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -162,7 +163,7 @@ func testAccCheckAllConnectionMySQLDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -264,7 +265,7 @@ func testAccCheckConnectionMySQLExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("connection MySQL not found: %s", name)
 		}
-		_, err = materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -282,7 +283,7 @@ func testAccCheckConnPostgresExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("connection postgres not found: %s", name)
 		}
-		_, err = materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -299,7 +300,7 @@ func testAccCheckAllConnPostgresDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
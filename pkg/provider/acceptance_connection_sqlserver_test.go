@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -262,7 +263,7 @@ func testAccCheckConnectionSQLServerExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("connection sqlserver not found: %s", name)
 		}
-		_, err = materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -279,7 +280,7 @@ func testAccCheckAllConnectionSQLServerDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnection(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanConnection(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -153,7 +154,7 @@ func testAccCheckConnSshTunnelExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("connection ssh tunnel not found: %s", name)
 		}
-		_, err = materialize.ScanConnectionSshTunnel(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanConnectionSshTunnel(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -170,7 +171,7 @@ func testAccCheckAllConnSshTunnelDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanConnectionSshTunnel(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanConnectionSshTunnel(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -145,7 +146,7 @@ func testAccCheckDatabaseExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("database not found: %s", name)
 		}
-		_, err = materialize.ScanDatabase(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanDatabase(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -162,7 +163,7 @@ func testAccCheckAllDatabasesDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanDatabase(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanDatabase(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("database %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
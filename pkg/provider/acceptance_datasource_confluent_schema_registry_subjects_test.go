@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceConfluentSchemaRegistrySubjects_basic(t *testing.T) {
+	topic := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceConfluentSchemaRegistrySubjects(topic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.materialize_confluent_schema_registry_subjects.topic", "global_compatibility_level"),
+					resource.TestCheckResourceAttrPair("data.materialize_confluent_schema_registry_subjects.topic", "value_compatibility_level", "data.materialize_confluent_schema_registry_subjects.topic", "global_compatibility_level"),
+					resource.TestCheckResourceAttr("data.materialize_confluent_schema_registry_subjects.topic", "subjects.#", "2"),
+					resource.TestCheckResourceAttr("data.materialize_confluent_schema_registry_subjects.topic", "subjects.0.name", topic+"-key"),
+					resource.TestCheckResourceAttr("data.materialize_confluent_schema_registry_subjects.topic", "subjects.0.versions.#", "0"),
+					resource.TestCheckResourceAttr("data.materialize_confluent_schema_registry_subjects.topic", "subjects.1.name", topic+"-value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceConfluentSchemaRegistrySubjects(topic string) string {
+	return fmt.Sprintf(`
+	data "materialize_confluent_schema_registry_subjects" "all" {
+		url = "http://localhost:8081"
+	}
+
+	data "materialize_confluent_schema_registry_subjects" "topic" {
+		url   = "http://localhost:8081"
+		topic = "%[1]s"
+	}
+	`, topic)
+}
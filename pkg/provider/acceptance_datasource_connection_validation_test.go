@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceConnectionValidation_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceConnectionValidation(nameSpace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.valid", "name", nameSpace+"_valid"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.valid", "schema_name", "public"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.valid", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.valid", "type", "kafka"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.valid", "error", ""),
+					resource.TestCheckResourceAttrPair("data.materialize_connection_validation.invalid", "connection_id", "materialize_connection_kafka.invalid", "id"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.invalid", "name", nameSpace+"_invalid"),
+					resource.TestCheckResourceAttr("data.materialize_connection_validation.invalid", "valid", "false"),
+					resource.TestMatchResourceAttr("data.materialize_connection_validation.invalid", "error", regexp.MustCompile(".+")),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceConnectionValidation(nameSpace string) string {
+	return fmt.Sprintf(`
+	resource "materialize_connection_kafka" "valid" {
+		name              = "%[1]s_valid"
+		security_protocol = "PLAINTEXT"
+
+		kafka_broker {
+			broker = "redpanda:9092"
+		}
+		validate = true
+	}
+
+	resource "materialize_connection_kafka" "invalid" {
+		name              = "%[1]s_invalid"
+		security_protocol = "PLAINTEXT"
+
+		kafka_broker {
+			broker = "%[1]s.invalid:9092"
+		}
+		validate = false
+	}
+
+	data "materialize_connection_validation" "valid" {
+		name = materialize_connection_kafka.valid.name
+	}
+
+	data "materialize_connection_validation" "invalid" {
+		connection_id = materialize_connection_kafka.invalid.id
+	}
+	`, nameSpace)
+}
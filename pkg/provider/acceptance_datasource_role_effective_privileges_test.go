@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceRoleEffectivePrivileges_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceRoleEffectivePrivileges(nameSpace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.materialize_role_effective_privileges.test", "privileges.*", map[string]string{
+						"kind":             "object",
+						"object_type":      "database",
+						"object_name":      nameSpace,
+						"privilege":        "USAGE",
+						"granted_via_role": nameSpace + "_parent",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.materialize_role_effective_privileges.test", "privileges.*", map[string]string{
+						"kind":             "system",
+						"privilege":        "CREATEDB",
+						"granted_via_role": nameSpace + "_grandparent",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceRoleEffectivePrivileges(nameSpace string) string {
+	return fmt.Sprintf(`
+	resource "materialize_role" "grandparent" {
+		name = "%[1]s_grandparent"
+	}
+
+	resource "materialize_role" "parent" {
+		name = "%[1]s_parent"
+	}
+
+	resource "materialize_role" "child" {
+		name = "%[1]s_child"
+	}
+
+	resource "materialize_role_grant" "parent" {
+		role_name   = materialize_role.grandparent.name
+		member_name = materialize_role.parent.name
+	}
+
+	resource "materialize_role_grant" "child" {
+		role_name   = materialize_role.parent.name
+		member_name = materialize_role.child.name
+	}
+
+	resource "materialize_database" "test" {
+		name = "%[1]s"
+	}
+
+	resource "materialize_database_grant" "test" {
+		role_name     = materialize_role.parent.name
+		privilege     = "USAGE"
+		database_name = materialize_database.test.name
+	}
+
+	resource "materialize_grant_system_privilege" "test" {
+		role_name = materialize_role.grandparent.name
+		privilege = "CREATEDB"
+	}
+
+	data "materialize_role_effective_privileges" "test" {
+		role_name = materialize_role.child.name
+		depends_on = [
+			materialize_role_grant.parent,
+			materialize_role_grant.child,
+			materialize_database_grant.test,
+			materialize_grant_system_privilege.test,
+		]
+	}
+	`, nameSpace)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -89,12 +90,12 @@ func testAccCheckGrantSystemPrivilegeExists(grantName, roleName, privilege strin
 			return fmt.Errorf("grant not found")
 		}
 
-		// roleId, err := materialize.RoleId(db, roleName)
+		// roleId, err := materialize.RoleId(context.Background(), db, roleName)
 		// if err != nil {
 		// 	return err
 		// }
 
-		_, err = materialize.ScanSystemPrivileges(db)
+		_, err = materialize.ScanSystemPrivileges(context.Background(), db)
 		if err != nil {
 			return err
 		}
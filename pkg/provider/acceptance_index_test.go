@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -165,7 +166,7 @@ func testAccCheckIndexExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("index not found: %s", name)
 		}
-		_, err = materialize.ScanIndex(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanIndex(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -194,7 +195,7 @@ func testAccCheckAllIndexDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanIndex(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanIndex(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("index %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
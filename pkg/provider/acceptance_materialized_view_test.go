@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -150,7 +151,7 @@ func testAccCheckMaterializedViewExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("Materialized View not found: %s", name)
 		}
-		_, err = materialize.ScanMaterializedView(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanMaterializedView(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -167,7 +168,7 @@ func testAccCheckAllMaterializedViewsDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanMaterializedView(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanMaterializedView(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("Materialized View %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkPolicyAttachment_role(t *testing.T) {
+	policyName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkPolicyAttachmentDestroyed(roleName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkPolicyAttachmentResource(policyName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkPolicyAttachmentExists(roleName, policyName),
+					resource.TestCheckResourceAttr("materialize_network_policy_attachment.test", "id", "aws/us-east-1:ROLE|"+roleName),
+					resource.TestCheckResourceAttr("materialize_network_policy_attachment.test", "network_policy_name", policyName),
+					resource.TestCheckResourceAttr("materialize_network_policy_attachment.test", "role_name", roleName),
+				),
+			},
+			{
+				ResourceName:      "materialize_network_policy_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckNetworkPolicyAttachmentExists reads the policy of the role from
+// the catalog rather than from the state.
+func testAccCheckNetworkPolicyAttachmentExists(roleName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := testAccProvider.Meta()
+		db, _, err := utils.GetDBClientFromMeta(meta, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DB client: %s", err)
+		}
+		p, err := materialize.ScanNetworkPolicyAttachment(context.Background(), db, roleName)
+		if err != nil {
+			return err
+		}
+		if p != policyName {
+			return fmt.Errorf("role %s has network policy %s, expected %s", roleName, p, policyName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckNetworkPolicyAttachmentDestroyed(roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := testAccProvider.Meta()
+		db, _, err := utils.GetDBClientFromMeta(meta, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DB client: %s", err)
+		}
+		_, err = materialize.ScanNetworkPolicyAttachment(context.Background(), db, roleName)
+		if err == nil {
+			return fmt.Errorf("role %s still has a network policy", roleName)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccNetworkPolicyAttachmentResource(policyName, roleName string) string {
+	return fmt.Sprintf(`
+	resource "materialize_network_policy" "test" {
+		name = "%[1]s"
+		rule {
+			name      = "all"
+			action    = "allow"
+			direction = "ingress"
+			address   = "0.0.0.0/0"
+		}
+	}
+
+	resource "materialize_role" "test" {
+		name = "%[2]s"
+	}
+
+	resource "materialize_network_policy_attachment" "test" {
+		network_policy_name = materialize_network_policy.test.name
+		role_name           = materialize_role.test.name
+	}
+	`, policyName, roleName)
+}
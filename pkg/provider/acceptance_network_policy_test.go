@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -136,7 +137,7 @@ func testAccCheckNetworkPolicyExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("Network Policy not found: %s", name)
 		}
-		_, err = materialize.ScanNetworkPolicy(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanNetworkPolicy(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -153,7 +154,7 @@ func testAccCheckAllNetworkPoliciesDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanNetworkPolicy(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanNetworkPolicy(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("Network Policy %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -95,17 +96,17 @@ func testAccCheckGrantRoleExists(grantName, roleName, granteeName string) resour
 			return fmt.Errorf("grant not found")
 		}
 
-		roleId, err := materialize.RoleId(db, roleName)
+		roleId, err := materialize.RoleId(context.Background(), db, roleName)
 		if err != nil {
 			return err
 		}
 
-		granteeId, err := materialize.RoleId(db, granteeName)
+		granteeId, err := materialize.RoleId(context.Background(), db, granteeName)
 		if err != nil {
 			return err
 		}
 
-		_, err = materialize.ScanRolePrivilege(db, roleId, granteeId)
+		_, err = materialize.ScanRolePrivilege(context.Background(), db, roleId, granteeId)
 		if err != nil {
 			return err
 		}
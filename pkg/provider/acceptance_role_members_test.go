@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRoleMembers_exclusive(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	roleName := nameSpace + "_role"
+	memberName := nameSpace + "_member"
+	outOfBandName := nameSpace + "_out_of_band"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleMembersResource(nameSpace, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_role_members.test", "role_name", roleName),
+					resource.TestCheckResourceAttr("materialize_role_members.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("materialize_role_members.test", "members.*", memberName),
+					resource.TestCheckResourceAttr("materialize_role_members.test", "unmanaged_members.#", "0"),
+					testAccCheckRoleMembers(roleName, memberName),
+					testAccGrantRoleOutOfBand(roleName, outOfBandName),
+				),
+			},
+			{
+				// Members granted outside of Terraform are only reported
+				Config: testAccRoleMembersResource(nameSpace, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_role_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("materialize_role_members.test", "unmanaged_members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("materialize_role_members.test", "unmanaged_members.*", outOfBandName),
+					testAccCheckRoleMembers(roleName, memberName, outOfBandName),
+				),
+			},
+			{
+				// Exclusive management revokes them
+				Config: testAccRoleMembersResource(nameSpace, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_role_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("materialize_role_members.test", "unmanaged_members.#", "0"),
+					testAccCheckRoleMembers(roleName, memberName),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleMembersResource(nameSpace string, exclusive bool) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "role" {
+	name = "%[1]s_role"
+}
+
+resource "materialize_role" "member" {
+	name = "%[1]s_member"
+}
+
+resource "materialize_role" "out_of_band" {
+	name = "%[1]s_out_of_band"
+}
+
+resource "materialize_role_members" "test" {
+	role_name = materialize_role.role.name
+	members   = [materialize_role.member.name]
+	exclusive = %[2]t
+
+	depends_on = [materialize_role.out_of_band]
+}
+`, nameSpace, exclusive)
+}
+
+func testAccCheckRoleMembers(roleName string, memberNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := testAccProvider.Meta()
+		db, _, err := utils.GetDBClientFromMeta(meta, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DB client: %s", err)
+		}
+
+		roleId, err := materialize.RoleId(context.Background(), db, roleName)
+		if err != nil {
+			return err
+		}
+
+		members, err := materialize.ListRoleMembers(context.Background(), db, roleId)
+		if err != nil {
+			return err
+		}
+
+		if len(members) != len(memberNames) {
+			return fmt.Errorf("role %s has %d members, expected %d", roleName, len(members), len(memberNames))
+		}
+		for _, name := range memberNames {
+			found := false
+			for _, m := range members {
+				if m.MemberName.String == name {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("role %s is missing member %s", roleName, name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGrantRoleOutOfBand(roleName, memberName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := testAccProvider.Meta()
+		db, _, err := utils.GetDBClientFromMeta(meta, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DB client: %s", err)
+		}
+		_, err = db.Exec(fmt.Sprintf(`GRANT %[1]s TO %[2]s;`, roleName, memberName))
+		return err
+	}
+}
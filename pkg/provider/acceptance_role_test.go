@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -204,7 +205,7 @@ func TestAccRole_createIfNotExistsAdoptsExisting(t *testing.T) {
 						t.Fatalf("error getting DB client: %s", err)
 					}
 					o := materialize.MaterializeObject{ObjectType: materialize.Role, Name: adoptName}
-					if err := materialize.NewRoleBuilder(db, o).Create(); err != nil {
+					if err := materialize.NewRoleBuilder(context.Background(), db, o).Create(); err != nil {
 						t.Fatalf("failed to pre-create role: %s", err)
 					}
 				},
@@ -251,7 +252,7 @@ func TestAccRole_createIfNotExistsDemotesOnAdopt(t *testing.T) {
 						t.Fatalf("error getting DB client: %s", err)
 					}
 					o := materialize.MaterializeObject{ObjectType: materialize.Role, Name: adoptName}
-					if err := materialize.NewRoleBuilder(db, o).Login(true).Create(); err != nil {
+					if err := materialize.NewRoleBuilder(context.Background(), db, o).Login(true).Create(); err != nil {
 						t.Fatalf("failed to pre-create role: %s", err)
 					}
 				},
@@ -344,7 +345,7 @@ func testAccCheckRoleExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("role not found: %s", name)
 		}
-		_, err = materialize.ScanRole(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanRole(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -361,7 +362,7 @@ func testAccCheckAllRolesDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanRole(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanRole(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("role %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -182,7 +183,7 @@ func testAccCheckSchemaExists(name string) resource.TestCheckFunc {
 		if r.Primary.Attributes["identify_by_name"] == "true" {
 			identifyByName = true
 		}
-		_, err = materialize.ScanSchema(db, utils.ExtractId(r.Primary.ID), identifyByName)
+		_, err = materialize.ScanSchema(context.Background(), db, utils.ExtractId(r.Primary.ID), identifyByName)
 		return err
 	}
 }
@@ -199,7 +200,7 @@ func testAccCheckAllSchemasDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err = materialize.ScanSchema(db, utils.ExtractId(r.Primary.ID), false)
+		_, err = materialize.ScanSchema(context.Background(), db, utils.ExtractId(r.Primary.ID), false)
 		if err == nil {
 			return fmt.Errorf("Schema %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
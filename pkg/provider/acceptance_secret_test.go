@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -196,7 +197,7 @@ func testAccCheckSecretExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("secret not found: %s", name)
 		}
-		_, err = materialize.ScanSecret(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSecret(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -213,7 +214,7 @@ func testAccCheckAllSecretsDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSecret(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSecret(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("secret %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -198,7 +199,7 @@ func testAccCheckSinkIcebergExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("error getting DB client: %s", err)
 		}
 
-		_, err = materialize.ScanSink(db, utils.ExtractId(rs.Primary.ID))
+		_, err = materialize.ScanSink(context.Background(), db, utils.ExtractId(rs.Primary.ID))
 		if err != nil {
 			return fmt.Errorf("sink Iceberg (%s) not found", rs.Primary.ID)
 		}
@@ -244,7 +245,7 @@ func testAccCheckSinkIcebergDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSink(db, utils.ExtractId(rs.Primary.ID))
+		_, err := materialize.ScanSink(context.Background(), db, utils.ExtractId(rs.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("sink Iceberg %s still exists", rs.Primary.ID)
 		}
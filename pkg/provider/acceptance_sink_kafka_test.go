@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -555,7 +556,7 @@ func testAccCheckSinkKafkaExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("sink kafka not found: %s", name)
 		}
-		_, err = materialize.ScanSink(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSink(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -584,7 +585,7 @@ func testAccCheckAllSinkKafkaDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSink(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSink(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("sink %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -457,7 +458,7 @@ func testAccCheckSourceKafkaExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source kafka not found: %s", name)
 		}
-		_, err = materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -474,7 +475,7 @@ func testAccCheckAllSourceKafkaDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -387,7 +388,7 @@ func testAccCheckSourceLoadGeneratorExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("SourceLoadGenerator not found: %s", name)
 		}
-		_, err = materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -416,7 +417,7 @@ func testAccCheckAllSourceLoadGeneratorsDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("SourceLoadGenerator %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
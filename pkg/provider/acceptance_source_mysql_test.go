@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -353,7 +354,7 @@ func testAccCheckSourceMySQLExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source mysql not found: %s", name)
 		}
-		_, err = materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -370,7 +371,7 @@ func testAccCheckAllSourceMySQLDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -426,7 +427,7 @@ func testAccCheckSourcePostgresExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source postgres not found: %s", name)
 		}
-		_, err = materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -443,7 +444,7 @@ func testAccCheckAllSourcePostgresDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
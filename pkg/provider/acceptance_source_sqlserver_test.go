@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -485,7 +486,7 @@ func testAccCheckSourceSQLServerExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source sqlserver not found: %s", name)
 		}
-		_, err = materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -502,7 +503,7 @@ func testAccCheckAllSourceSQLServerDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
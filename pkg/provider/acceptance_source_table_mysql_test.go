@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -230,7 +231,7 @@ func testAccCheckSourceTableExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source table not found: %s", name)
 		}
-		_, err = materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSourceTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -247,7 +248,7 @@ func testAccCheckAllSourceTableDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSourceTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source table %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
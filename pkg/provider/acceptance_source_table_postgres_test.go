@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -257,7 +258,7 @@ func testAccCheckSourceTablePostgresExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source table not found: %s", name)
 		}
-		_, err = materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSourceTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -274,7 +275,7 @@ func testAccCheckAllSourceTablePostgresDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSourceTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source table %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -242,7 +243,7 @@ func testAccCheckSourceTableSQLServerExists(name string) resource.TestCheckFunc
 		if !ok {
 			return fmt.Errorf("source table not found: %s", name)
 		}
-		_, err = materialize.ScanSourceTableSQLServer(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSourceTableSQLServer(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -259,7 +260,7 @@ func testAccCheckAllSourceTableSQLServerDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSourceTableSQLServer(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSourceTableSQLServer(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source table %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
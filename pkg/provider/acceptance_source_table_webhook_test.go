@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -158,7 +159,7 @@ func testAccCheckSourceTableWebhookExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source table not found: %s", name)
 		}
-		_, err = materialize.ScanSourceTableWebhook(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSourceTableWebhook(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -175,7 +176,7 @@ func testAccCheckAllSourceTableWebhookDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSourceTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source table %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
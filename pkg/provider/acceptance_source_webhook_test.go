@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -150,7 +152,48 @@ func TestAccSourceWebhook_update(t *testing.T) {
 					resource.TestCheckResourceAttr("materialize_source_webhook.test", "name", newSourceName),
 					resource.TestCheckResourceAttr("materialize_source_webhook.test", "ownership_role", roleName),
 					resource.TestCheckResourceAttr("materialize_source_webhook.test", "comment", "New Comment"),
-					resource.TestCheckResourceAttrSet("materialize_source_webhook.test", "url"),
+					resource.TestMatchResourceAttr("materialize_source_webhook.test", "url", regexp.MustCompile(fmt.Sprintf("/%s$", newSourceName))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceWebhook_rotateSecret(t *testing.T) {
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	var sourceId, sourceUrl string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceWebhookSecretResource(secretName, sourceName, "c2VjcmV0Cg=="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceWebhookExists("materialize_source_webhook.test"),
+					func(s *terraform.State) error {
+						r := s.RootModule().Resources["materialize_source_webhook.test"]
+						sourceId, sourceUrl = r.Primary.ID, r.Primary.Attributes["url"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccSourceWebhookSecretResource(secretName, sourceName, "cm90YXRlZAo="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceWebhookExists("materialize_source_webhook.test"),
+					resource.TestCheckResourceAttr("materialize_secret.hmac", "value", "cm90YXRlZAo="),
+					func(s *terraform.State) error {
+						r := s.RootModule().Resources["materialize_source_webhook.test"]
+						if r.Primary.ID != sourceId {
+							return fmt.Errorf("source was recreated: id changed from %s to %s", sourceId, r.Primary.ID)
+						}
+						if r.Primary.Attributes["url"] != sourceUrl {
+							return fmt.Errorf("url changed from %s to %s", sourceUrl, r.Primary.Attributes["url"])
+						}
+						return nil
+					},
 				),
 			},
 		},
@@ -241,6 +284,45 @@ func testAccSourceWebhookResource(roleName, secretName, clusterName, sourceName,
 	`, roleName, secretName, clusterName, sourceName, sourceOwner, comment)
 }
 
+func testAccSourceWebhookSecretResource(secretName, sourceName, secretValue string) string {
+	return fmt.Sprintf(`
+	resource "materialize_secret" "hmac" {
+		name  = "%[1]s"
+		value = "%[3]s"
+	}
+
+	resource "materialize_source_webhook" "test" {
+		name         = "%[2]s"
+		cluster_name = "quickstart"
+		body_format  = "json"
+
+		check_options {
+			field {
+				body = true
+			}
+			alias = "body"
+		}
+
+		check_options {
+			field {
+				headers = true
+			}
+			alias = "headers"
+		}
+
+		check_options {
+			field {
+				secret {
+					name = materialize_secret.hmac.name
+				}
+			}
+			alias = "key"
+		}
+		check_expression = "decode(headers->'x-signature', 'base64') = hmac(body, key, 'sha256')"
+	}
+	`, secretName, sourceName, secretValue)
+}
+
 func testAccSourceWebhookSegmentResource(sourceName string) string {
 	return fmt.Sprintf(`
 	resource "materialize_secret" "basic_auth" {
@@ -349,7 +431,7 @@ func testAccCheckSourceWebhookExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("source webhook not found: %s", name)
 		}
-		_, err = materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -366,7 +448,7 @@ func testAccCheckAllSourceWebhookDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanSource(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanSource(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("source %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSwap_schema(t *testing.T) {
+	blue := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	green := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	var nameId, swapWithId string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSwapSchemaResource(blue, green, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_swap.test", "id", fmt.Sprintf("aws/us-east-1:SWAP|SCHEMA|materialize|%s|%s", blue, green)),
+					resource.TestCheckResourceAttr("materialize_swap.test", "object_type", "SCHEMA"),
+					testAccCheckSwapIds("materialize_swap.test", &nameId, &swapWithId),
+				),
+			},
+			{
+				Config: testAccSwapSchemaResource(blue, green, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("materialize_swap.test", "name_id", &swapWithId),
+					resource.TestCheckResourceAttrPtr("materialize_swap.test", "swap_with_id", &nameId),
+				),
+			},
+		},
+	})
+}
+
+func testAccSwapSchemaResource(blue, green, version string) string {
+	return fmt.Sprintf(`
+	resource "materialize_schema" "blue" {
+		name             = "%[1]s"
+		identify_by_name = true
+	}
+
+	resource "materialize_schema" "green" {
+		name             = "%[2]s"
+		identify_by_name = true
+	}
+
+	resource "materialize_swap" "test" {
+		object_type = "SCHEMA"
+		name        = materialize_schema.blue.name
+		swap_with   = materialize_schema.green.name
+
+		triggers = {
+			version = "%[3]s"
+		}
+	}
+	`, blue, green, version)
+}
+
+// testAccCheckSwapIds records the IDs currently holding each name so a later
+// step can verify that they were exchanged.
+func testAccCheckSwapIds(name string, nameId, swapWithId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("swap not found: %s", name)
+		}
+		*nameId = r.Primary.Attributes["name_id"]
+		*swapWithId = r.Primary.Attributes["swap_with_id"]
+		if *nameId == "" || *swapWithId == "" || *nameId == *swapWithId {
+			return fmt.Errorf("unexpected ids for %s: %q and %q", name, *nameId, *swapWithId)
+		}
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -229,7 +230,7 @@ func testAccCheckTableExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("Table not found: %s", name)
 		}
-		_, err = materialize.ScanTable(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -246,7 +247,7 @@ func testAccCheckAllTablesDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanTable(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanTable(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("Table %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -234,7 +235,7 @@ func testAccCheckTypeExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("Type not found: %s", name)
 		}
-		_, err = materialize.ScanType(db, utils.ExtractId(r.Primary.ID))
+		_, err = materialize.ScanType(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		return err
 	}
 }
@@ -251,7 +252,7 @@ func testAccCheckAllTypesDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanType(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanType(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("Type %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
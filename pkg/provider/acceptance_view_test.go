@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -45,10 +46,28 @@ func TestAccView_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"statement"},
 			},
+			{
+				ResourceName:            "materialize_view.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccViewImportByName("materialize_view.test", viewName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"statement"},
+			},
 		},
 	})
 }
 
+func testAccViewImportByName(name, viewName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("view not found: %s", name)
+		}
+		region := utils.ExtractRegion(r.Primary.ID)
+		return fmt.Sprintf("%s:name:materialize.public.%s", region, materialize.QuoteIdentifier(viewName)), nil
+	}
+}
+
 func TestAccView_update(t *testing.T) {
 	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
 	viewName := fmt.Sprintf("old_%s", slug)
@@ -88,6 +107,45 @@ func TestAccView_update(t *testing.T) {
 	})
 }
 
+func TestAccView_identifyByName(t *testing.T) {
+	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	viewName := fmt.Sprintf("old_%s", slug)
+	newViewName := fmt.Sprintf("new_%s", slug)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllViewsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewIdentifyByNameResource(viewName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "identify_by_name", "true"),
+					resource.TestCheckResourceAttr("materialize_view.test", "id", "aws/us-east-1:name:materialize|public|"+viewName),
+				),
+			},
+			{
+				Config: testAccViewIdentifyByNameResource(newViewName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "name", newViewName),
+					resource.TestCheckResourceAttr("materialize_view.test", "id", "aws/us-east-1:name:materialize|public|"+newViewName),
+				),
+			},
+		},
+	})
+}
+
+func testAccViewIdentifyByNameResource(viewName string) string {
+	return fmt.Sprintf(`
+	resource "materialize_view" "test" {
+		name             = "%[1]s"
+		statement        = "SELECT 1 AS id"
+		identify_by_name = true
+	}
+	`, viewName)
+}
+
 func TestAccView_disappears(t *testing.T) {
 	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	view2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -149,7 +207,18 @@ func testAccCheckViewExists(name string) resource.TestCheckFunc {
 		if !ok {
 			return fmt.Errorf("View not found: %s", name)
 		}
-		_, err = materialize.ScanView(db, utils.ExtractId(r.Primary.ID))
+		id := utils.ExtractId(r.Primary.ID)
+		if r.Primary.Attributes["identify_by_name"] == "true" {
+			id, err = materialize.ViewId(context.Background(), db, materialize.MaterializeObject{
+				Name:         r.Primary.Attributes["name"],
+				SchemaName:   r.Primary.Attributes["schema_name"],
+				DatabaseName: r.Primary.Attributes["database_name"],
+			})
+			if err != nil {
+				return err
+			}
+		}
+		_, err = materialize.ScanView(context.Background(), db, id)
 		return err
 	}
 }
@@ -166,7 +235,7 @@ func testAccCheckAllViewsDestroyed(s *terraform.State) error {
 			continue
 		}
 
-		_, err := materialize.ScanView(db, utils.ExtractId(r.Primary.ID))
+		_, err := materialize.ScanView(context.Background(), db, utils.ExtractId(r.Primary.ID))
 		if err == nil {
 			return fmt.Errorf("View %v still exists", utils.ExtractId(r.Primary.ID))
 		} else if err != sql.ErrNoRows {
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -238,15 +241,15 @@ func testAccCheckGrantExists(object materialize.MaterializeObject, grantName, ro
 		if !ok {
 			return fmt.Errorf("grant not found")
 		}
-		id, err := materialize.ObjectId(db, object)
+		id, err := materialize.ObjectId(context.Background(), db, object)
 		if err != nil {
 			return err
 		}
-		roleId, err := materialize.RoleId(db, roleName)
+		roleId, err := materialize.RoleId(context.Background(), db, roleName)
 		if err != nil {
 			return err
 		}
-		g, err := materialize.ScanPrivileges(db, object.ObjectType, id)
+		g, err := materialize.ScanPrivileges(context.Background(), db, object.ObjectType, id)
 		if err != nil {
 			return err
 		}
@@ -281,15 +284,15 @@ func testAccCheckGrantDefaultPrivilegeExists(objectType, grantName, granteeName,
 		if !ok {
 			return fmt.Errorf("default grant not found")
 		}
-		granteeId, err := materialize.RoleId(db, grantName)
+		granteeId, err := materialize.RoleId(context.Background(), db, grantName)
 		if err != nil {
 			return err
 		}
-		targetId, err := materialize.RoleId(db, targetName)
+		targetId, err := materialize.RoleId(context.Background(), db, targetName)
 		if err != nil {
 			return err
 		}
-		g, err := materialize.ScanDefaultPrivilege(db, objectType, granteeId, targetId, "", "")
+		g, err := materialize.ScanDefaultPrivilege(context.Background(), db, objectType, granteeId, targetId, "", "")
 		if err != nil {
 			return err
 		}
@@ -300,3 +303,83 @@ func testAccCheckGrantDefaultPrivilegeExists(objectType, grantName, granteeName,
 		return nil
 	}
 }
+
+func TestOIDCTokenSourceFromResourceData(t *testing.T) {
+	s := Provider("test").Schema
+
+	empty := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	tokenSource, diags := oidcTokenSourceFromResourceData(context.Background(), empty, nil)
+	if diags.HasError() || tokenSource != nil {
+		t.Fatalf("expected no token source when oidc is unset, got %v %v", tokenSource, diags)
+	}
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("projected-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"oidc": []interface{}{map[string]interface{}{"token_file": path}},
+	})
+	tokenSource, diags = oidcTokenSourceFromResourceData(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	token, err := tokenSource.Token(context.Background())
+	if err != nil || token != "projected-token" {
+		t.Fatalf("unexpected token %q: %v", token, err)
+	}
+
+	missing := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"oidc": []interface{}{map[string]interface{}{"token_file": filepath.Join(t.TempDir(), "missing")}},
+	})
+	if _, diags := oidcTokenSourceFromResourceData(context.Background(), missing, nil); !diags.HasError() {
+		t.Fatal("expected an error for a missing token file")
+	}
+}
+
+func TestRetryConfigFromResourceData(t *testing.T) {
+	s := Provider("test").Schema
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"max_retries":       2,
+		"max_retry_backoff": "10s",
+		"request_timeout":   "0s",
+	})
+
+	config := retryConfigFromResourceData(d)
+	if config.MaxRetries != 2 || config.MaxBackoff != 10*time.Second || config.RequestTimeout != 0 {
+		t.Fatalf("unexpected retry config: %+v", config)
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	for _, v := range []string{"500ms", "30s", "0s", "2m"} {
+		if diags := validateDuration(v, nil); diags.HasError() {
+			t.Errorf("expected %q to be valid, got %v", v, diags)
+		}
+	}
+	for _, v := range []string{"30", "soon", "-1s"} {
+		if diags := validateDuration(v, nil); !diags.HasError() {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestAPITransportFromResourceData(t *testing.T) {
+	s := Provider("test").Schema
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"proxy_url":       "http://proxy.example.com:3128",
+		"tls_min_version": "1.3",
+	})
+	if _, diags := apiTransportFromResourceData(d); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	missing := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"ca_bundle": filepath.Join(t.TempDir(), "missing.pem"),
+	})
+	if _, diags := apiTransportFromResourceData(missing); !diags.HasError() {
+		t.Fatal("expected an error for a missing CA bundle")
+	}
+}
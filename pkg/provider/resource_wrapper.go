@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// apiResources are managed through the Materialize APIs rather than SQL, so
+// their changes cannot be recorded by a dry run.
+var apiResources = map[string]bool{
+	"materialize_app_password":      true,
+	"materialize_user":              true,
+	"materialize_region":            true,
+	"materialize_sso_config":        true,
+	"materialize_sso_domain":        true,
+	"materialize_sso_group_mapping": true,
+	"materialize_sso_default_roles": true,
+	"materialize_scim_config":       true,
+	"materialize_scim_group":        true,
+	"materialize_scim_group_users":  true,
+	"materialize_scim_group_roles":  true,
+}
+
+// defaultTimeout matches the timeout Terraform applies when none is declared.
+const defaultTimeout = 20 * time.Minute
+
+// wrapResource names the resource on the context passed to its functions, so
+// that the statements they run are attributed to it in the statement log and
+// in their spans, traces them and handles dry runs.
+func wrapResource(name string, r *schema.Resource) {
+	if r.Timeouts == nil {
+		r.Timeouts = resourceTimeouts(r)
+	}
+
+	if create := r.CreateContext; create != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if isDryRun(meta) {
+				return runDryRun(ctx, d, meta, name, "create", create)
+			}
+			return runTraced(ctx, d, meta, name, "create", create)
+		}
+	}
+
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return runTraced(ctx, d, meta, name, "read", read)
+		}
+	}
+
+	if update := r.UpdateContext; update != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if isDryRun(meta) {
+				return runDryRun(ctx, d, meta, name, "update", update)
+			}
+			return runTraced(ctx, d, meta, name, "update", update)
+		}
+	}
+
+	if del := r.DeleteContext; del != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if isDryRun(meta) {
+				return runDryRun(ctx, d, meta, name, "delete", del)
+			}
+			return runTraced(ctx, d, meta, name, "delete", del)
+		}
+	}
+}
+
+// resourceTimeouts lets the timeouts of every operation of the resource be
+// configured. The context of the operation expires with the timeout, which
+// cancels the statement in progress on the server.
+func resourceTimeouts(r *schema.Resource) *schema.ResourceTimeout {
+	timeouts := &schema.ResourceTimeout{}
+	if r.CreateContext != nil {
+		timeouts.Create = schema.DefaultTimeout(defaultTimeout)
+	}
+	if r.UpdateContext != nil {
+		timeouts.Update = schema.DefaultTimeout(defaultTimeout)
+	}
+	if r.DeleteContext != nil {
+		timeouts.Delete = schema.DefaultTimeout(defaultTimeout)
+	}
+	return timeouts
+}
+
+// wrapDataSource attributes the queries of a data source to it, as
+// data.<name>, and traces its reads.
+func wrapDataSource(name string, r *schema.Resource) {
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return runTraced(ctx, d, meta, "data."+name, "read", read)
+		}
+	}
+}
+
+// runTraced runs fn for the resource type name in a span, which the spans of
+// its statements and API requests are children of when they get ctx.
+func runTraced(ctx context.Context, d *schema.ResourceData, meta interface{}, name, operation string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) diag.Diagnostics {
+	region := utils.ExtractRegion(d.Id())
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	ref := clients.ResourceRef{Type: name, Object: resourceObject(d), Region: region}
+	ctx = clients.ContextWithResource(ctx, ref)
+	attributes := []attribute.KeyValue{
+		clients.AttributeResource.String(name),
+		clients.AttributeRegion.String(region),
+	}
+	if ref.Object != "" {
+		attributes = append(attributes, clients.AttributeObject.String(ref.Object))
+	}
+	ctx, span := clients.Tracer().Start(ctx, name+" "+operation, trace.WithAttributes(attributes...))
+	defer span.End()
+
+	diags := fn(ctx, d, meta)
+	for _, diagnostic := range diags {
+		if diagnostic.Severity == diag.Error {
+			span.SetStatus(codes.Error, diagnostic.Summary)
+			break
+		}
+	}
+	return diags
+}
+
+// resourceObject names the object of the resource by its qualified name, or by
+// its ID if it has no name. It is empty when creating an object without a name.
+func resourceObject(d *schema.ResourceData) string {
+	name, ok := d.Get("name").(string)
+	if !ok || name == "" {
+		return d.Id()
+	}
+
+	var fields []string
+	for _, key := range []string{"database_name", "schema_name"} {
+		if v, ok := d.Get(key).(string); ok && v != "" {
+			fields = append(fields, v)
+		}
+	}
+	return materialize.QualifiedName(append(fields, name)...)
+}
+
+func isDryRun(meta interface{}) bool {
+	providerMeta, ok := meta.(*utils.ProviderMeta)
+	return ok && providerMeta.IsDryRun()
+}
+
+func dryRunUnsupported(name string) diag.Diagnostics {
+	return diag.Errorf("%s cannot be applied in a dry run, it is managed through the Materialize API rather than SQL", name)
+}
+
+// runDryRun runs fn with its statements recorded rather than run. The
+// operation always fails, so that Terraform keeps the prior state of the
+// resource: nothing was created, changed or dropped.
+func runDryRun(ctx context.Context, d *schema.ResourceData, meta interface{}, name, operation string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) diag.Diagnostics {
+	if apiResources[name] {
+		return dryRunUnsupported(name)
+	}
+
+	id := d.Id()
+	diags := runTraced(ctx, d, meta, name, operation, fn)
+
+	// Restore the ID that fn may have set or cleared, and store the prior
+	// state rather than the configuration
+	d.SetId(id)
+	d.Partial(true)
+
+	return dryRunDiagnostics(name, operation, diags)
+}
+
+// dryRunDiagnostics reports that the operation was only recorded. Objects
+// that were not created cannot be read back, so those errors are dropped,
+// other errors are reported as they are.
+func dryRunDiagnostics(name, operation string, diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+	for _, diagnostic := range diags {
+		if diagnostic.Severity == diag.Error && strings.Contains(diagnostic.Summary, sql.ErrNoRows.Error()) {
+			continue
+		}
+		result = append(result, diagnostic)
+	}
+
+	return append(result, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Dry run: %s was not %sd", name, operation),
+		Detail:   "The statements were recorded in sql_audit_log without being run. The state of the resource is unchanged.",
+	})
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func wrappedTestResource(name string, create schema.CreateContextFunc) *schema.Resource {
+	r := &schema.Resource{
+		CreateContext: create,
+		Schema: map[string]*schema.Schema{
+			"name":   {Type: schema.TypeString, Required: true},
+			"region": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	wrapResource(name, r)
+	return r
+}
+
+func TestWrapResourceDryRunCreate(t *testing.T) {
+	r := require.New(t)
+	meta := &utils.ProviderMeta{StatementLog: &clients.StatementLog{DryRun: true}}
+
+	res := wrappedTestResource("materialize_view", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		d.SetId("aws/us-west-2:u1")
+		return diag.FromErr(sql.ErrNoRows)
+	})
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "view", "region": "aws/us-west-2"})
+
+	// The object cannot be read back, only the dry run itself is reported
+	diags := res.CreateContext(context.Background(), d, meta)
+	r.True(diags.HasError())
+	r.Len(diags, 1)
+	r.Equal("Dry run: materialize_view was not created", diags[0].Summary)
+
+	// Nothing is stored in state
+	r.Empty(d.Id())
+	r.Nil(d.State())
+}
+
+func TestWrapResourceDryRunDelete(t *testing.T) {
+	r := require.New(t)
+	meta := &utils.ProviderMeta{StatementLog: &clients.StatementLog{DryRun: true}}
+
+	res := &schema.Resource{
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("")
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+	}
+	wrapResource("materialize_view", res)
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "view"})
+	d.SetId("aws/us-east-1:u1")
+
+	// The object still exists, so it must stay in state
+	diags := res.DeleteContext(context.Background(), d, meta)
+	r.True(diags.HasError())
+	r.Equal("Dry run: materialize_view was not deleted", diags[0].Summary)
+	r.Equal("aws/us-east-1:u1", d.Id())
+}
+
+func TestWrapResourceDryRunKeepsErrors(t *testing.T) {
+	r := require.New(t)
+	meta := &utils.ProviderMeta{StatementLog: &clients.StatementLog{DryRun: true}}
+
+	res := wrappedTestResource("materialize_view", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.Errorf("permission denied for SCHEMA \"database.schema\"")
+	})
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "view"})
+
+	diags := res.CreateContext(context.Background(), d, meta)
+	r.Len(diags, 2)
+	r.Equal(diag.Error, diags[0].Severity)
+	r.Contains(diags[0].Summary, "permission denied")
+}
+
+func TestWrapResourceDryRunApiResource(t *testing.T) {
+	r := require.New(t)
+	meta := &utils.ProviderMeta{StatementLog: &clients.StatementLog{DryRun: true}}
+
+	res := wrappedTestResource("materialize_app_password", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		t.Fatal("API resources must not be created in a dry run")
+		return nil
+	})
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "password"})
+
+	diags := res.CreateContext(context.Background(), d, meta)
+	r.True(diags.HasError())
+	r.Contains(diags[0].Summary, "cannot be applied in a dry run")
+}
+
+func TestWrapResourcePassesErrors(t *testing.T) {
+	r := require.New(t)
+	meta := &utils.ProviderMeta{}
+
+	res := wrappedTestResource("materialize_view", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.Errorf("no view found")
+	})
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "view"})
+
+	diags := res.CreateContext(context.Background(), d, meta)
+	r.True(diags.HasError())
+	r.Empty(d.Id())
+}
+
+func TestWrapResourceNamesResource(t *testing.T) {
+	r := require.New(t)
+	meta := &utils.ProviderMeta{}
+
+	res := wrappedTestResource("materialize_view", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// The meta is passed on as is, the resource is named on the context
+		r.Same(meta, m)
+		ref, ok := clients.ResourceFromContext(ctx)
+		r.True(ok)
+		r.Equal(clients.ResourceRef{Type: "materialize_view", Object: `"view"`, Region: "aws/us-east-1"}, ref)
+		return nil
+	})
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "view", "region": "aws/us-east-1"})
+
+	r.Empty(res.CreateContext(context.Background(), d, meta))
+}
+
+func TestResourceObject(t *testing.T) {
+	r := require.New(t)
+	s := map[string]*schema.Schema{
+		"name":          {Type: schema.TypeString, Optional: true},
+		"schema_name":   {Type: schema.TypeString, Optional: true},
+		"database_name": {Type: schema.TypeString, Optional: true},
+	}
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"name": "view", "schema_name": "public", "database_name": "materialize"})
+	r.Equal(`"materialize"."public"."view"`, resourceObject(d))
+
+	// Objects without a name are named by their ID
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	d.SetId("aws/us-east-1:GRANT|SCHEMA|u1|u2|USAGE")
+	r.Equal("aws/us-east-1:GRANT|SCHEMA|u1|u2|USAGE", resourceObject(d))
+}
+
+func TestWrapResourceTraced(t *testing.T) {
+	r := require.New(t)
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	res := wrappedTestResource("materialize_view", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// Spans started by the resource are children of the span of the operation
+		_, span := clients.Tracer().Start(ctx, "CREATE")
+		span.End()
+		return diag.Errorf("unable to create view")
+	})
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "view", "region": "aws/us-east-1"})
+
+	res.CreateContext(context.Background(), d, &utils.ProviderMeta{})
+
+	spans := recorder.Ended()
+	r.Len(spans, 2)
+	r.Equal("CREATE", spans[0].Name())
+	r.Equal("materialize_view create", spans[1].Name())
+	r.Equal(spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	r.Equal(trace.SpanKindInternal, spans[1].SpanKind())
+	r.Equal(codes.Error, spans[1].Status().Code)
+	r.Contains(spans[1].Attributes(), clients.AttributeResource.String("materialize_view"))
+	r.Contains(spans[1].Attributes(), clients.AttributeRegion.String("aws/us-east-1"))
+	r.Contains(spans[1].Attributes(), clients.AttributeObject.String(`"view"`))
+}
+
+func TestWrapDataSourceRead(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		meta := &utils.ProviderMeta{
+			Frontegg: &clients.FronteggClient{
+				Endpoint:    serverURL,
+				HTTPClient:  &http.Client{},
+				TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+
+		// The data source as wrapped by the provider, not its constructor
+		res := Provider("test").DataSourcesMap["materialize_user"]
+		d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"email": "test@example.com"})
+
+		diags := res.ReadContext(context.Background(), d, meta)
+		r.Empty(diags)
+		r.Equal("new-mock-user-id", d.Id())
+	})
+}
@@ -14,13 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var clusterSchema = map[string]*schema.Schema{
-	"name":           ObjectNameSchema("cluster", true, true),
-	"comment":        CommentSchema(false),
-	"ownership_role": OwnershipRoleSchema(),
-	"size":           SizeSchema("managed cluster", false, false),
+	"name":            ObjectNameSchema("cluster", true, true),
+	"comment":         CommentSchema(false),
+	"ownership_role":  OwnershipRoleSchema(),
+	"execute_as_role": ExecuteAsRoleSchema(),
+	"session_options": SessionOptionsSchema(),
+	"size":            SizeSchema("managed cluster", false, false),
 	"replication_factor": {
 		Description:  "The number of replicas of each dataflow-powered object to maintain.",
 		Type:         schema.TypeInt,
@@ -178,7 +181,7 @@ func clusterRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	value := utils.ExtractId(fullId)
 	useNameAsId := d.Get("identify_by_name").(bool)
 
-	s, err := materialize.ScanCluster(metaDb, value, idType == "name")
+	s, err := materialize.ScanCluster(ctx, metaDb, value, idType == "name")
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -207,7 +210,7 @@ func clusterRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	// apply to block on it use the `wait_until_ready` option instead.
 	size := s.Size.String
 	replicationFactor := s.ReplicationFactor.Int64
-	pending, inFlight, err := materialize.ScanClusterPendingReconfiguration(metaDb, s.ClusterId.String)
+	pending, inFlight, err := materialize.ScanClusterPendingReconfiguration(ctx, metaDb, s.ClusterId.String)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -243,7 +246,7 @@ func clusterRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	// Returns an empty strategy on Materialize versions without the backing
 	// view; genuine query failures surface as an error.
-	strategy, err := materialize.ScanClusterAutoScalingStrategy(metaDb, s.ClusterId.String)
+	strategy, err := materialize.ScanClusterAutoScalingStrategy(ctx, metaDb, s.ClusterId.String)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -322,58 +325,51 @@ func clusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Cluster, Name: clusterName}
-	b := materialize.NewClusterBuilder(metaDb, o)
 
-	// managed cluster options
-	if size, ok := d.GetOk("size"); ok {
-		b.Size(size.(string))
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewClusterBuilder(ctx, db, o)
 
-		if v, ok := d.GetOkExists("replication_factor"); ok {
-			r := v.(int)
-			b.ReplicationFactor(&r)
-		}
-
-		// TODO: remove this once the disk attr is removed
-		// The disk attr is deprecated and is not configurable
-		log.Printf("[DEBUG] disk option is deprecated.")
+		// managed cluster options
+		if size, ok := d.GetOk("size"); ok {
+			b.Size(size.(string))
 
-		if v, ok := d.GetOk("availability_zones"); ok && len(v.([]interface{})) > 0 {
-			f, err := materialize.GetSliceValueString("availability_zones", v.([]interface{}))
-			if err != nil {
-				return diag.FromErr(err)
+			if v, ok := d.GetOkExists("replication_factor"); ok {
+				r := v.(int)
+				b.ReplicationFactor(&r)
 			}
-			b.AvailabilityZones(f)
-		}
 
-		if v, ok := d.GetOk("introspection_interval"); ok {
-			b.IntrospectionInterval(v.(string))
-		}
+			// TODO: remove this once the disk attr is removed
+			// The disk attr is deprecated and is not configurable
+			log.Printf("[DEBUG] disk option is deprecated.")
 
-		if v, ok := d.GetOk("introspection_debugging"); ok && v.(bool) {
-			b.IntrospectionDebugging()
-		}
+			if v, ok := d.GetOk("availability_zones"); ok && len(v.([]interface{})) > 0 {
+				f, err := materialize.GetSliceValueString("availability_zones", v.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				b.AvailabilityZones(f)
+			}
 
-		if v, ok := d.GetOk("scheduling"); ok {
-			b.Scheduling(v.([]interface{}))
-		}
+			if v, ok := d.GetOk("introspection_interval"); ok {
+				b.IntrospectionInterval(v.(string))
+			}
 
-		if v, ok := d.GetOk("auto_scaling_strategy"); ok {
-			b.AutoScalingStrategy(v.([]interface{}))
-		}
-	}
+			if v, ok := d.GetOk("introspection_debugging"); ok && v.(bool) {
+				b.IntrospectionDebugging()
+			}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+			if v, ok := d.GetOk("scheduling"); ok {
+				b.Scheduling(v.([]interface{}))
+			}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+			if v, ok := d.GetOk("auto_scaling_strategy"); ok {
+				b.AutoScalingStrategy(v.([]interface{}))
+			}
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
@@ -385,7 +381,7 @@ func clusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		value = clusterName
 	} else {
 		idType = "id"
-		clusterId, err := materialize.ClusterId(metaDb, o)
+		clusterId, err := materialize.ClusterId(ctx, metaDb, o)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -419,7 +415,7 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 			newValue = clusterName
 		} else {
 			newIdType = "id"
-			clusterId, err := materialize.ClusterId(metaDb, o)
+			clusterId, err := materialize.ClusterId(ctx, metaDb, o)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -434,13 +430,13 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	b := materialize.NewClusterBuilder(metaDb, o)
+	b := materialize.NewClusterBuilder(ctx, metaDb, o)
 	changed := false
 	if d.HasChange("size") {
 		_, newSize := d.GetChange("size")
@@ -525,7 +521,7 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -543,7 +539,7 @@ func clusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: clusterName}
-	b := materialize.NewClusterBuilder(metaDb, o)
+	b := materialize.NewClusterBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
@@ -562,7 +558,7 @@ func clusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}
 	value := utils.ExtractId(fullId)
 	identifyByName := idType == "name"
 
-	s, err := materialize.ScanCluster(metaDb, value, identifyByName)
+	s, err := materialize.ScanCluster(ctx, metaDb, value, identifyByName)
 	if err != nil {
 		return nil, fmt.Errorf("error importing cluster %s: %s", fullId, err)
 	}
@@ -584,7 +580,7 @@ func clusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}
 	d.Set("availability_zones", s.AvailabilityZones)
 	d.Set("comment", s.Comment.String)
 
-	strategy, err := materialize.ScanClusterAutoScalingStrategy(metaDb, s.ClusterId.String)
+	strategy, err := materialize.ScanClusterAutoScalingStrategy(ctx, metaDb, s.ClusterId.String)
 	if err != nil {
 		return nil, err
 	}
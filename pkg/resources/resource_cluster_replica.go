@@ -56,7 +56,7 @@ func clusterReplicaRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanClusterReplica(metaDb, utils.ExtractId(i))
+	s, err := materialize.ScanClusterReplica(ctx, metaDb, utils.ExtractId(i))
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -107,7 +107,7 @@ func clusterReplicaCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Name:        replicaName,
 		ClusterName: clusterName,
 	}
-	b := materialize.NewClusterReplicaBuilder(metaDb, o)
+	b := materialize.NewClusterReplicaBuilder(ctx, metaDb, o)
 
 	if v, ok := d.GetOk("size"); ok {
 		b.Size(v.(string))
@@ -136,17 +136,16 @@ func clusterReplicaCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	// object comment
 	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
+		comment := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := comment.Object(v.(string)); err != nil {
 			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+			return dropAfterFailure(o, b, err)
 		}
 	}
 
 	// set id
-	i, err := materialize.ClusterReplicaId(metaDb, o)
+	i, err := materialize.ClusterReplicaId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -171,7 +170,7 @@ func clusterReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -190,7 +189,7 @@ func clusterReplicaDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: replicaName, ClusterName: clusterName}
-	b := materialize.NewClusterReplicaBuilder(metaDb, o)
+	b := materialize.NewClusterReplicaBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
@@ -12,13 +12,20 @@ import (
 )
 
 func connectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanConnection(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseConnection, materialize.ConnectionId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanConnection(ctx, metaDb, i)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -26,7 +33,7 @@ func connectionRead(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.ConnectionName.String))
 
 	if err := d.Set("name", s.ConnectionName.String); err != nil {
 		return diag.FromErr(err)
@@ -71,7 +78,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -79,7 +86,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("host") {
 		oldHost, newHost := d.GetChange("host")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"HOST": newHost,
 		}
@@ -91,7 +98,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("port") {
 		oldPort, newPort := d.GetChange("port")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"PORT": newPort,
 		}
@@ -104,7 +111,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 	if d.HasChange("user") {
 		oldUser, newUser := d.GetChange("user")
 		user := materialize.GetValueSecretStruct(newUser)
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"USER": user,
 		}
@@ -117,7 +124,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 	if d.HasChange("password") {
 		oldPassword, newPassword := d.GetChange("password")
 		password := materialize.GetIdentifierSchemaStruct(newPassword)
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"PASSWORD": password,
 		}
@@ -129,7 +136,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("database") {
 		oldDatabase, newDatabase := d.GetChange("database")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"DATABASE": newDatabase,
 		}
@@ -141,7 +148,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("ssh_tunnel") {
 		oldTunnel, newTunnel := d.GetChange("ssh_tunnel")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newTunnel == nil || len(newTunnel.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"SSH TUNNEL"}, validate); err != nil {
 				d.Set("ssh_tunnel", oldTunnel)
@@ -161,7 +168,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("ssl_certificate_authority") {
 		oldSslCa, newSslCa := d.GetChange("ssl_certificate_authority")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newSslCa == nil || len(newSslCa.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"SSL CERTIFICATE AUTHORITY"}, validate); err != nil {
 				d.Set("ssl_certificate_authority", oldSslCa)
@@ -182,7 +189,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 	if d.HasChange("ssl_certificate") || d.HasChange("ssl_key") {
 		oldSslCert, newSslCert := d.GetChange("ssl_certificate")
 		oldSslKey, newSslKey := d.GetChange("ssl_key")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 
 		if (newSslCert == nil || len(newSslCert.([]interface{})) == 0) || (newSslKey == nil || len(newSslKey.([]interface{})) == 0) {
 			// Drop both SSL CERTIFICATE and SSL KEY in a single statement
@@ -213,7 +220,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("ssl_mode") {
 		oldSslMode, newSslMode := d.GetChange("ssl_mode")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newSslMode == nil || newSslMode == "" {
 			if err := b.AlterDrop([]string{"SSL MODE"}, validate); err != nil {
 				d.Set("ssl_mode", oldSslMode)
@@ -232,7 +239,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("aws_privatelink") {
 		oldAwsPrivatelink, newAwsPrivatelink := d.GetChange("aws_privatelink")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newAwsPrivatelink == nil || len(newAwsPrivatelink.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"AWS PRIVATELINK"}, validate); err != nil {
 				d.Set("aws_privatelink", oldAwsPrivatelink)
@@ -252,7 +259,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -261,7 +268,7 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -281,7 +288,7 @@ func connectionDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnection(metaDb, o)
+	b := materialize.NewConnection(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionAwsSchema = map[string]*schema.Schema{
@@ -55,9 +56,12 @@ var connectionAwsSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionAws() *schema.Resource {
@@ -66,11 +70,11 @@ func ConnectionAws() *schema.Resource {
 
 		CreateContext: connectionAwsCreate,
 		ReadContext:   connectionAwsRead,
-		UpdateContext: connectionAwsUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionAwsUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionAwsSchema,
@@ -78,13 +82,12 @@ func ConnectionAws() *schema.Resource {
 }
 
 func connectionAwsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanConnectionAws(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseConnection, materialize.ConnectionId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -92,7 +95,15 @@ func connectionAwsRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanConnectionAws(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.ConnectionName.String))
 
 	if err := d.Set("name", s.ConnectionName.String); err != nil {
 		return diag.FromErr(err)
@@ -174,60 +185,53 @@ func connectionAwsCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionAwsBuilder(metaDb, o)
-
-	if v, ok := d.GetOk("endpoint"); ok {
-		b.Endpoint(v.(string))
-	}
 
-	if v, ok := d.GetOk("aws_region"); ok {
-		b.AwsRegion(v.(string))
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionAwsBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("access_key_id"); ok {
-		a := materialize.GetValueSecretStruct(v)
-		b.AccessKeyId(a)
-	}
+		if v, ok := d.GetOk("endpoint"); ok {
+			b.Endpoint(v.(string))
+		}
 
-	if v, ok := d.GetOk("secret_access_key"); ok {
-		s := materialize.GetIdentifierSchemaStruct(v)
-		b.SecretAccessKey(s)
-	}
+		if v, ok := d.GetOk("aws_region"); ok {
+			b.AwsRegion(v.(string))
+		}
 
-	if v, ok := d.GetOk("session_token"); ok {
-		s := materialize.GetValueSecretStruct(v)
-		b.SessionToken(s)
-	}
+		if v, ok := d.GetOk("access_key_id"); ok {
+			a := materialize.GetValueSecretStruct(v)
+			b.AccessKeyId(a)
+		}
 
-	if v, ok := d.GetOk("assume_role_arn"); ok {
-		b.AssumeRoleArn(v.(string))
-	}
+		if v, ok := d.GetOk("secret_access_key"); ok {
+			s := materialize.GetIdentifierSchemaStruct(v)
+			b.SecretAccessKey(s)
+		}
 
-	if v, ok := d.GetOk("assume_role_session_name"); ok {
-		b.AssumeRoleSessionName(v.(string))
-	}
+		if v, ok := d.GetOk("session_token"); ok {
+			s := materialize.GetValueSecretStruct(v)
+			b.SessionToken(s)
+		}
 
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
+		if v, ok := d.GetOk("assume_role_arn"); ok {
+			b.AssumeRoleArn(v.(string))
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("assume_role_session_name"); ok {
+			b.AssumeRoleSessionName(v.(string))
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -251,7 +255,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnectionAwsBuilder(metaDb, o)
+		b := materialize.NewConnectionAwsBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -259,7 +263,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("endpoint") {
 		oldEndpoint, newEndpoint := d.GetChange("endpoint")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newEndpoint == nil || newEndpoint == "" {
 			if err := b.AlterDrop([]string{"ENDPOINT"}, validate); err != nil {
 				d.Set("endpoint", oldEndpoint)
@@ -278,7 +282,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("aws_region") {
 		oldRegion, newRegion := d.GetChange("aws_region")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newRegion == nil || newRegion == "" {
 			if err := b.AlterDrop([]string{"REGION"}, validate); err != nil {
 				d.Set("aws_region", oldRegion)
@@ -297,7 +301,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("access_key_id") {
 		oldAccessKeyId, newAccessKeyId := d.GetChange("access_key_id")
-		b := materialize.NewConnectionAwsBuilder(metaDb, o)
+		b := materialize.NewConnectionAwsBuilder(ctx, metaDb, o)
 		if newAccessKeyId == nil || newAccessKeyId == "" || len(newAccessKeyId.([]interface{})) == 0 {
 			// TODO: Can't drop access key id without secret session token
 			if err := b.AlterDrop([]string{"ACCESS KEY ID"}, validate); err != nil {
@@ -317,7 +321,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("secret_access_key") {
 		oldSecretAccessKey, newSecretAccessKey := d.GetChange("secret_access_key")
-		b := materialize.NewConnectionAwsBuilder(metaDb, o)
+		b := materialize.NewConnectionAwsBuilder(ctx, metaDb, o)
 		if newSecretAccessKey == nil || newSecretAccessKey == "" || len(newSecretAccessKey.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"SECRET ACCESS KEY"}, validate); err != nil {
 				d.Set("secret_access_key", oldSecretAccessKey)
@@ -336,7 +340,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("session_token") {
 		oldSessionToken, newSessionToken := d.GetChange("session_token")
-		b := materialize.NewConnectionAwsBuilder(metaDb, o)
+		b := materialize.NewConnectionAwsBuilder(ctx, metaDb, o)
 		if newSessionToken == nil || newSessionToken == "" || len(newSessionToken.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"SESSION TOKEN"}, validate); err != nil {
 				d.Set("session_token", oldSessionToken)
@@ -356,7 +360,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChange("assume_role_arn") || d.HasChange("assume_role_session_name") {
 		oldAssumeRoleArn, newAssumeRoleArn := d.GetChange("assume_role_arn")
 		oldAssumeRoleSessionName, newAssumeRoleSessionName := d.GetChange("assume_role_session_name")
-		b := materialize.NewConnectionAwsBuilder(metaDb, o)
+		b := materialize.NewConnectionAwsBuilder(ctx, metaDb, o)
 		options := make(map[string]interface{})
 
 		if newAssumeRoleArn != nil && newAssumeRoleArn != "" {
@@ -383,7 +387,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -391,7 +395,7 @@ func connectionAwsUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
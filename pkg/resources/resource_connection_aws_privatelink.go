@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionAwsPrivatelinkSchema = map[string]*schema.Schema{
@@ -36,9 +37,12 @@ var connectionAwsPrivatelinkSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Sensitive:   true,
 	},
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionAwsPrivatelink() *schema.Resource {
@@ -47,11 +51,11 @@ func ConnectionAwsPrivatelink() *schema.Resource {
 
 		CreateContext: connectionAwsPrivatelinkCreate,
 		ReadContext:   connectionAwsPrivatelinkRead,
-		UpdateContext: connectionAwsPrivatelinkUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionAwsPrivatelinkUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionAwsPrivatelinkSchema,
@@ -59,13 +63,12 @@ func ConnectionAwsPrivatelink() *schema.Resource {
 }
 
 func connectionAwsPrivatelinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanConnectionAwsPrivatelink(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseConnection, materialize.ConnectionId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -73,7 +76,15 @@ func connectionAwsPrivatelinkRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanConnectionAwsPrivatelink(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.ConnectionName.String))
 
 	if err := d.Set("name", s.ConnectionName.String); err != nil {
 		return diag.FromErr(err)
@@ -117,41 +128,34 @@ func connectionAwsPrivatelinkCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionAwsPrivatelinkBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("service_name"); ok {
-		b.PrivateLinkServiceName(v.(string))
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionAwsPrivatelinkBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("availability_zones"); ok && len(v.([]interface{})) > 0 {
-		azs, err := materialize.GetSliceValueString("availability_zones", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("service_name"); ok {
+			b.PrivateLinkServiceName(v.(string))
 		}
-		b.PrivateLinkAvailabilityZones(azs)
-	}
 
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("availability_zones"); ok && len(v.([]interface{})) > 0 {
+			azs, err := materialize.GetSliceValueString("availability_zones", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.PrivateLinkAvailabilityZones(azs)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -175,7 +179,7 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnectionAwsPrivatelinkBuilder(metaDb, o)
+		b := materialize.NewConnectionAwsPrivatelinkBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -183,7 +187,7 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("service_name") {
 		oldServiceName, newServiceName := d.GetChange("service_name")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"SERVICE NAME": newServiceName.(string),
 		}
@@ -195,7 +199,7 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("availability_zones") {
 		oldAzs, newAzs := d.GetChange("availability_zones")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		newAzsSlice, err := materialize.GetSliceValueString("availability_zones", newAzs.([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
@@ -211,7 +215,7 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -219,7 +223,7 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
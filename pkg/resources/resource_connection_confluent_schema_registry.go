@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionConfluentSchemaRegistrySchema = map[string]*schema.Schema{
@@ -48,9 +49,12 @@ var connectionConfluentSchemaRegistrySchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionConfluentSchemaRegistry() *schema.Resource {
@@ -59,11 +63,11 @@ func ConnectionConfluentSchemaRegistry() *schema.Resource {
 
 		CreateContext: connectionConfluentSchemaRegistryCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionConfluentSchemaRegistryUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionConfluentSchemaRegistryUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionConfluentSchemaRegistrySchema,
@@ -80,68 +84,61 @@ func connectionConfluentSchemaRegistryCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionConfluentSchemaRegistryBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("url"); ok {
-		b.ConfluentSchemaRegistryUrl(v.(string))
-	}
-
-	if v, ok := d.GetOk("ssl_certificate_authority"); ok {
-		ssl_ca := materialize.GetValueSecretStruct(v)
-		b.ConfluentSchemaRegistrySSLCa(ssl_ca)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionConfluentSchemaRegistryBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("ssl_certificate"); ok {
-		ssl_cert := materialize.GetValueSecretStruct(v)
-		b.ConfluentSchemaRegistrySSLCert(ssl_cert)
-	}
+		if v, ok := d.GetOk("url"); ok {
+			b.ConfluentSchemaRegistryUrl(v.(string))
+		}
 
-	if v, ok := d.GetOk("ssl_key"); ok {
-		key := materialize.GetIdentifierSchemaStruct(v)
-		b.ConfluentSchemaRegistrySSLKey(key)
-	}
+		if v, ok := d.GetOk("ssl_certificate_authority"); ok {
+			ssl_ca := materialize.GetValueSecretStruct(v)
+			b.ConfluentSchemaRegistrySSLCa(ssl_ca)
+		}
 
-	if v, ok := d.GetOk("username"); ok {
-		user := materialize.GetValueSecretStruct(v)
-		b.ConfluentSchemaRegistryUsername(user)
-	}
+		if v, ok := d.GetOk("ssl_certificate"); ok {
+			ssl_cert := materialize.GetValueSecretStruct(v)
+			b.ConfluentSchemaRegistrySSLCert(ssl_cert)
+		}
 
-	if v, ok := d.GetOk("password"); ok {
-		pass := materialize.GetIdentifierSchemaStruct(v)
-		b.ConfluentSchemaRegistryPassword(pass)
-	}
+		if v, ok := d.GetOk("ssl_key"); ok {
+			key := materialize.GetIdentifierSchemaStruct(v)
+			b.ConfluentSchemaRegistrySSLKey(key)
+		}
 
-	if v, ok := d.GetOk("ssh_tunnel"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.ConfluentSchemaRegistrySSHTunnel(conn)
-	}
+		if v, ok := d.GetOk("username"); ok {
+			user := materialize.GetValueSecretStruct(v)
+			b.ConfluentSchemaRegistryUsername(user)
+		}
 
-	if v, ok := d.GetOk("aws_privatelink"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.ConfluentSchemaRegistryAWSPrivateLink(conn)
-	}
+		if v, ok := d.GetOk("password"); ok {
+			pass := materialize.GetIdentifierSchemaStruct(v)
+			b.ConfluentSchemaRegistryPassword(pass)
+		}
 
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
+		if v, ok := d.GetOk("ssh_tunnel"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.ConfluentSchemaRegistrySSHTunnel(conn)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("aws_privatelink"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.ConfluentSchemaRegistryAWSPrivateLink(conn)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -165,7 +162,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -173,7 +170,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	if d.HasChange("url") {
 		oldUrl, newUrl := d.GetChange("url")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"URL": newUrl,
 		}
@@ -185,7 +182,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	if d.HasChange("username") {
 		oldUser, newUser := d.GetChange("username")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newUser == nil || len(newUser.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"USER"}, validate); err != nil {
 				d.Set("username", oldUser)
@@ -205,7 +202,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	if d.HasChange("password") {
 		oldPassword, newPassword := d.GetChange("password")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newPassword == nil || len(newPassword.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"PASSWORD"}, validate); err != nil {
 				d.Set("password", oldPassword)
@@ -225,7 +222,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	if d.HasChange("ssl_certificate_authority") {
 		oldSslCa, newSslCa := d.GetChange("ssl_certificate_authority")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newSslCa == nil || len(newSslCa.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"SSL CERTIFICATE AUTHORITY"}, validate); err != nil {
 				d.Set("ssl_certificate_authority", oldSslCa)
@@ -246,7 +243,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 	if d.HasChange("ssl_certificate") || d.HasChange("ssl_key") {
 		oldSslCert, newSslCert := d.GetChange("ssl_certificate")
 		oldSslKey, newSslKey := d.GetChange("ssl_key")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 
 		if (newSslCert == nil || len(newSslCert.([]interface{})) == 0) || (newSslKey == nil || len(newSslKey.([]interface{})) == 0) {
 			// Drop both SSL CERTIFICATE and SSL KEY in a single statement
@@ -278,7 +275,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 	// TODO: Uncomment when SSH TUNNEL and AWS PRIVATELINK are supported in the Materialize
 	// if d.HasChange("ssh_tunnel") {
 	// 	oldTunnel, newTunnel := d.GetChange("ssh_tunnel")
-	// 	b := materialize.NewConnection(metaDb, o)
+	// 	b := materialize.NewConnection(ctx, metaDb, o)
 	// 	if newTunnel == nil || len(newTunnel.([]interface{})) == 0 {
 	// 		if err := b.AlterDrop([]string{"SSH TUNNEL"}, validate); err != nil {
 	// 			d.Set("ssh_tunnel", oldTunnel)
@@ -298,7 +295,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	// if d.HasChange("aws_privatelink") {
 	// 	oldAwsPrivatelink, newAwsPrivatelink := d.GetChange("aws_privatelink")
-	// 	b := materialize.NewConnection(metaDb, o)
+	// 	b := materialize.NewConnection(ctx, metaDb, o)
 	// 	if newAwsPrivatelink == nil || len(newAwsPrivatelink.([]interface{})) == 0 {
 	// 		if err := b.AlterDrop([]string{"AWS PRIVATELINK"}, validate); err != nil {
 	// 			d.Set("aws_privatelink", oldAwsPrivatelink)
@@ -318,7 +315,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -327,7 +324,7 @@ func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.Reso
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionIcebergCatalogSchema = map[string]*schema.Schema{
@@ -40,9 +41,12 @@ var connectionIcebergCatalogSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	}),
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionIcebergCatalog() *schema.Resource {
@@ -51,11 +55,11 @@ func ConnectionIcebergCatalog() *schema.Resource {
 
 		CreateContext: connectionIcebergCatalogCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionIcebergCatalogUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionIcebergCatalogUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionIcebergCatalogSchema,
@@ -72,46 +76,39 @@ func connectionIcebergCatalogCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionIcebergCatalogBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("catalog_type"); ok {
-		b.CatalogType(v.(string))
-	}
-
-	if v, ok := d.GetOk("url"); ok {
-		b.Url(v.(string))
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionIcebergCatalogBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("warehouse"); ok {
-		b.Warehouse(v.(string))
-	}
+		if v, ok := d.GetOk("catalog_type"); ok {
+			b.CatalogType(v.(string))
+		}
 
-	if v, ok := d.GetOk("aws_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.AwsConnection(conn)
-	}
+		if v, ok := d.GetOk("url"); ok {
+			b.Url(v.(string))
+		}
 
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
+		if v, ok := d.GetOk("warehouse"); ok {
+			b.Warehouse(v.(string))
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("aws_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.AwsConnection(conn)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -134,7 +131,7 @@ func connectionIcebergCatalogUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnectionIcebergCatalogBuilder(metaDb, o)
+		b := materialize.NewConnectionIcebergCatalogBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -147,7 +144,7 @@ func connectionIcebergCatalogUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -155,7 +152,7 @@ func connectionIcebergCatalogUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionKafkaSchema = map[string]*schema.Schema{
@@ -184,9 +185,12 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    false,
 	}),
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionKafka() *schema.Resource {
@@ -195,11 +199,11 @@ func ConnectionKafka() *schema.Resource {
 
 		CreateContext: connectionKafkaCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionKafkaUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionKafkaUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionKafkaSchema,
@@ -216,95 +220,88 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionKafkaBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("kafka_broker"); ok {
-		brokers := materialize.GetKafkaBrokersStruct(v)
-		b.KafkaBrokers(brokers)
-	}
-
-	if v, ok := d.GetOk("broker_matching_rule"); ok {
-		rules := materialize.GetKafkaBrokerMatchingRulesStruct(v)
-		b.KafkaBrokerMatchingRules(rules)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionKafkaBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("aws_privatelink"); ok {
-		privatelink := materialize.GetAwsPrivateLinkConnectionStruct(v)
-		b.KafkaAwsPrivateLink(privatelink)
-	}
+		if v, ok := d.GetOk("kafka_broker"); ok {
+			brokers := materialize.GetKafkaBrokersStruct(v)
+			b.KafkaBrokers(brokers)
+		}
 
-	if v, ok := d.GetOk("aws_connection"); ok {
-		awsConn := materialize.GetIdentifierSchemaStruct(v)
-		b.AwsConnection(awsConn)
-	}
+		if v, ok := d.GetOk("broker_matching_rule"); ok {
+			rules := materialize.GetKafkaBrokerMatchingRulesStruct(v)
+			b.KafkaBrokerMatchingRules(rules)
+		}
 
-	if v, ok := d.GetOk("security_protocol"); ok {
-		b.KafkaSecurityProtocol(v.(string))
-	}
+		if v, ok := d.GetOk("aws_privatelink"); ok {
+			privatelink := materialize.GetAwsPrivateLinkConnectionStruct(v)
+			b.KafkaAwsPrivateLink(privatelink)
+		}
 
-	if v, ok := d.GetOk("progress_topic"); ok {
-		b.KafkaProgressTopic(v.(string))
-	}
+		if v, ok := d.GetOk("aws_connection"); ok {
+			awsConn := materialize.GetIdentifierSchemaStruct(v)
+			b.AwsConnection(awsConn)
+		}
 
-	if v, ok := d.GetOk("progress_topic_replication_factor"); ok {
-		b.KafkaProgressTopicReplicationFactor(v.(int))
-	}
+		if v, ok := d.GetOk("security_protocol"); ok {
+			b.KafkaSecurityProtocol(v.(string))
+		}
 
-	if v, ok := d.GetOk("ssl_certificate_authority"); ok {
-		ssl_ca := materialize.GetValueSecretStruct(v)
-		b.KafkaSSLCa(ssl_ca)
-	}
+		if v, ok := d.GetOk("progress_topic"); ok {
+			b.KafkaProgressTopic(v.(string))
+		}
 
-	if v, ok := d.GetOk("ssl_certificate"); ok {
-		ssl_cert := materialize.GetValueSecretStruct(v)
-		b.KafkaSSLCert(ssl_cert)
-	}
+		if v, ok := d.GetOk("progress_topic_replication_factor"); ok {
+			b.KafkaProgressTopicReplicationFactor(v.(int))
+		}
 
-	if v, ok := d.GetOk("ssl_key"); ok {
-		key := materialize.GetIdentifierSchemaStruct(v)
-		b.KafkaSSLKey(key)
-	}
+		if v, ok := d.GetOk("ssl_certificate_authority"); ok {
+			ssl_ca := materialize.GetValueSecretStruct(v)
+			b.KafkaSSLCa(ssl_ca)
+		}
 
-	if v, ok := d.GetOk("sasl_mechanisms"); ok {
-		b.KafkaSASLMechanisms(v.(string))
-	}
+		if v, ok := d.GetOk("ssl_certificate"); ok {
+			ssl_cert := materialize.GetValueSecretStruct(v)
+			b.KafkaSSLCert(ssl_cert)
+		}
 
-	if v, ok := d.GetOk("sasl_username"); ok {
-		sasl_username := materialize.GetValueSecretStruct(v)
-		b.KafkaSASLUsername(sasl_username)
-	}
+		if v, ok := d.GetOk("ssl_key"); ok {
+			key := materialize.GetIdentifierSchemaStruct(v)
+			b.KafkaSSLKey(key)
+		}
 
-	if v, ok := d.GetOk("sasl_password"); ok {
-		pass := materialize.GetIdentifierSchemaStruct(v)
-		b.KafkaSASLPassword(pass)
-	}
+		if v, ok := d.GetOk("sasl_mechanisms"); ok {
+			b.KafkaSASLMechanisms(v.(string))
+		}
 
-	if v, ok := d.GetOk("ssh_tunnel"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.KafkaSSHTunnel(conn)
-	}
+		if v, ok := d.GetOk("sasl_username"); ok {
+			sasl_username := materialize.GetValueSecretStruct(v)
+			b.KafkaSASLUsername(sasl_username)
+		}
 
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
+		if v, ok := d.GetOk("sasl_password"); ok {
+			pass := materialize.GetIdentifierSchemaStruct(v)
+			b.KafkaSASLPassword(pass)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("ssh_tunnel"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.KafkaSSHTunnel(conn)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -325,7 +322,7 @@ func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
 
-	b := materialize.NewConnectionKafkaBuilder(metaDb, o)
+	b := materialize.NewConnectionKafkaBuilder(ctx, metaDb, o)
 	options := map[string]interface{}{}
 	resetOptions := []string{}
 	addResetOption := func(option string) {
@@ -340,7 +337,7 @@ func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -479,7 +476,7 @@ func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if d.HasChange("ssh_tunnel") {
 		oldTunnel, newTunnel := d.GetChange("ssh_tunnel")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		if newTunnel == nil || len(newTunnel.([]interface{})) == 0 {
 			if err := b.AlterDrop([]string{"SSH TUNNEL"}, validate); err != nil {
 				d.Set("ssh_tunnel", oldTunnel)
@@ -499,7 +496,7 @@ func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -508,7 +505,7 @@ func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
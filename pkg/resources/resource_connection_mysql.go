@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionMySQLSchema = map[string]*schema.Schema{
@@ -65,9 +66,12 @@ var connectionMySQLSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    false,
 	}),
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionMySQL() *schema.Resource {
@@ -76,11 +80,11 @@ func ConnectionMySQL() *schema.Resource {
 
 		CreateContext: connectionMySQLCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionMySQLSchema,
@@ -97,76 +101,69 @@ func connectionMySQLCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionMySQLBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("host"); ok {
-		b.MySQLHost(v.(string))
-	}
-
-	if v, ok := d.GetOk("port"); ok {
-		b.MySQLPort(v.(int))
-	}
-
-	if v, ok := d.GetOk("user"); ok {
-		user := materialize.GetValueSecretStruct(v)
-		b.MySQLUser(user)
-	}
-
-	if v, ok := d.GetOk("password"); ok {
-		pass := materialize.GetIdentifierSchemaStruct(v)
-		b.MySQLPassword(pass)
-	}
-
-	if v, ok := d.GetOk("ssl_mode"); ok {
-		b.MySQLSSLMode(v.(string))
-	}
-
-	if v, ok := d.GetOk("ssl_certificate_authority"); ok {
-		ssl_ca := materialize.GetValueSecretStruct(v)
-		b.MySQLSSLCa(ssl_ca)
-	}
-
-	if v, ok := d.GetOk("ssl_certificate"); ok {
-		ssl_cert := materialize.GetValueSecretStruct(v)
-		b.MySQLSSLCert(ssl_cert)
-	}
-
-	if v, ok := d.GetOk("ssl_key"); ok {
-		k := materialize.GetIdentifierSchemaStruct(v)
-		b.MySQLSSLKey(k)
-	}
-
-	if v, ok := d.GetOk("ssh_tunnel"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.MySQLSSHTunnel(conn)
-	}
-
-	if v, ok := d.GetOk("aws_privatelink"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.MySQLAWSPrivateLink(conn)
-	}
-
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
-
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionMySQLBuilder(ctx, db, o)
+
+		if v, ok := d.GetOk("host"); ok {
+			b.MySQLHost(v.(string))
+		}
+
+		if v, ok := d.GetOk("port"); ok {
+			b.MySQLPort(v.(int))
+		}
+
+		if v, ok := d.GetOk("user"); ok {
+			user := materialize.GetValueSecretStruct(v)
+			b.MySQLUser(user)
+		}
+
+		if v, ok := d.GetOk("password"); ok {
+			pass := materialize.GetIdentifierSchemaStruct(v)
+			b.MySQLPassword(pass)
+		}
+
+		if v, ok := d.GetOk("ssl_mode"); ok {
+			b.MySQLSSLMode(v.(string))
+		}
+
+		if v, ok := d.GetOk("ssl_certificate_authority"); ok {
+			ssl_ca := materialize.GetValueSecretStruct(v)
+			b.MySQLSSLCa(ssl_ca)
+		}
+
+		if v, ok := d.GetOk("ssl_certificate"); ok {
+			ssl_cert := materialize.GetValueSecretStruct(v)
+			b.MySQLSSLCert(ssl_cert)
+		}
+
+		if v, ok := d.GetOk("ssl_key"); ok {
+			k := materialize.GetIdentifierSchemaStruct(v)
+			b.MySQLSSLKey(k)
+		}
+
+		if v, ok := d.GetOk("ssh_tunnel"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.MySQLSSHTunnel(conn)
+		}
+
+		if v, ok := d.GetOk("aws_privatelink"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.MySQLAWSPrivateLink(conn)
+		}
+
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
+
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionPostgresSchema = map[string]*schema.Schema{
@@ -65,9 +66,12 @@ var connectionPostgresSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    false,
 	}),
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionPostgres() *schema.Resource {
@@ -76,11 +80,11 @@ func ConnectionPostgres() *schema.Resource {
 
 		CreateContext: connectionPostgresCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionPostgresSchema,
@@ -97,84 +101,77 @@ func connectionPostgresCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionPostgresBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("connection_type"); ok {
-		b.ConnectionType(v.(string))
-	}
-
-	if v, ok := d.GetOk("host"); ok {
-		b.PostgresHost(v.(string))
-	}
-
-	if v, ok := d.GetOk("port"); ok {
-		b.PostgresPort(v.(int))
-	}
-
-	if v, ok := d.GetOk("user"); ok {
-		user := materialize.GetValueSecretStruct(v)
-		b.PostgresUser(user)
-	}
-
-	if v, ok := d.GetOk("password"); ok {
-		pass := materialize.GetIdentifierSchemaStruct(v)
-		b.PostgresPassword(pass)
-	}
-
-	if v, ok := d.GetOk("database"); ok {
-		b.PostgresDatabase(v.(string))
-	}
-
-	if v, ok := d.GetOk("ssl_mode"); ok {
-		b.PostgresSSLMode(v.(string))
-	}
-
-	if v, ok := d.GetOk("ssl_certificate_authority"); ok {
-		ssl_ca := materialize.GetValueSecretStruct(v)
-		b.PostgresSSLCa(ssl_ca)
-	}
-
-	if v, ok := d.GetOk("ssl_certificate"); ok {
-		ssl_cert := materialize.GetValueSecretStruct(v)
-		b.PostgresSSLCert(ssl_cert)
-	}
-
-	if v, ok := d.GetOk("ssl_key"); ok {
-		k := materialize.GetIdentifierSchemaStruct(v)
-		b.PostgresSSLKey(k)
-	}
-
-	if v, ok := d.GetOk("aws_privatelink"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.PostgresAWSPrivateLink(conn)
-	}
-
-	if v, ok := d.GetOk("ssh_tunnel"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.PostgresSSHTunnel(conn)
-	}
-
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
-
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionPostgresBuilder(ctx, db, o)
+
+		if v, ok := d.GetOk("connection_type"); ok {
+			b.ConnectionType(v.(string))
+		}
+
+		if v, ok := d.GetOk("host"); ok {
+			b.PostgresHost(v.(string))
+		}
+
+		if v, ok := d.GetOk("port"); ok {
+			b.PostgresPort(v.(int))
+		}
+
+		if v, ok := d.GetOk("user"); ok {
+			user := materialize.GetValueSecretStruct(v)
+			b.PostgresUser(user)
+		}
+
+		if v, ok := d.GetOk("password"); ok {
+			pass := materialize.GetIdentifierSchemaStruct(v)
+			b.PostgresPassword(pass)
+		}
+
+		if v, ok := d.GetOk("database"); ok {
+			b.PostgresDatabase(v.(string))
+		}
+
+		if v, ok := d.GetOk("ssl_mode"); ok {
+			b.PostgresSSLMode(v.(string))
+		}
+
+		if v, ok := d.GetOk("ssl_certificate_authority"); ok {
+			ssl_ca := materialize.GetValueSecretStruct(v)
+			b.PostgresSSLCa(ssl_ca)
+		}
+
+		if v, ok := d.GetOk("ssl_certificate"); ok {
+			ssl_cert := materialize.GetValueSecretStruct(v)
+			b.PostgresSSLCert(ssl_cert)
+		}
+
+		if v, ok := d.GetOk("ssl_key"); ok {
+			k := materialize.GetIdentifierSchemaStruct(v)
+			b.PostgresSSLKey(k)
+		}
+
+		if v, ok := d.GetOk("aws_privatelink"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.PostgresAWSPrivateLink(conn)
+		}
+
+		if v, ok := d.GetOk("ssh_tunnel"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.PostgresSSHTunnel(conn)
+		}
+
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
+
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
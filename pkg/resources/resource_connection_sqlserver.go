@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionSQLServerSchema = map[string]*schema.Schema{
@@ -66,6 +67,9 @@ var connectionSQLServerSchema = map[string]*schema.Schema{
 	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the SQL Server database.", false, true),
 	"validate":                  ValidateConnectionSchema(),
 	"ownership_role":            OwnershipRoleSchema(),
+	"execute_as_role":           ExecuteAsRoleSchema(),
+	"session_options":           SessionOptionsSchema(),
+	"identify_by_name":          IdentifyByNameSchema("connection"),
 	"region":                    RegionSchema(),
 }
 
@@ -75,11 +79,11 @@ func ConnectionSQLServer() *schema.Resource {
 
 		CreateContext: connectionSQLServerCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionSQLServerSchema,
@@ -96,74 +100,67 @@ func connectionSQLServerCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionSQLServerBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("connection_type"); ok {
-		b.ConnectionType(v.(string))
-	}
-
-	if v, ok := d.GetOk("host"); ok {
-		b.SQLServerHost(v.(string))
-	}
-
-	if v, ok := d.GetOk("port"); ok {
-		b.SQLServerPort(v.(int))
-	}
-
-	if v, ok := d.GetOk("user"); ok {
-		user := materialize.GetValueSecretStruct(v)
-		b.SQLServerUser(user)
-	}
-
-	if v, ok := d.GetOk("password"); ok {
-		pass := materialize.GetIdentifierSchemaStruct(v)
-		b.SQLServerPassword(pass)
-	}
-
-	if v, ok := d.GetOk("database"); ok {
-		b.SQLServerDatabase(v.(string))
-	}
-
-	if v, ok := d.GetOk("aws_privatelink"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.SQLServerAWSPrivateLink(conn)
-	}
-
-	if v, ok := d.GetOk("ssh_tunnel"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.SQLServerSSHTunnel(conn)
-	}
-
-	if v, ok := d.GetOk("ssl_mode"); ok {
-		b.SQLServerSSLMode(v.(string))
-	}
-
-	if v, ok := d.GetOk("ssl_certificate_authority"); ok {
-		ssl_ca := materialize.GetValueSecretStruct(v)
-		b.SQLServerSSLCertificateAuthority(ssl_ca)
-	}
-
-	if v, ok := d.GetOk("validate"); ok {
-		b.Validate(v.(bool))
-	}
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
-
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionSQLServerBuilder(ctx, db, o)
+
+		if v, ok := d.GetOk("connection_type"); ok {
+			b.ConnectionType(v.(string))
+		}
+
+		if v, ok := d.GetOk("host"); ok {
+			b.SQLServerHost(v.(string))
+		}
+
+		if v, ok := d.GetOk("port"); ok {
+			b.SQLServerPort(v.(int))
+		}
+
+		if v, ok := d.GetOk("user"); ok {
+			user := materialize.GetValueSecretStruct(v)
+			b.SQLServerUser(user)
+		}
+
+		if v, ok := d.GetOk("password"); ok {
+			pass := materialize.GetIdentifierSchemaStruct(v)
+			b.SQLServerPassword(pass)
+		}
+
+		if v, ok := d.GetOk("database"); ok {
+			b.SQLServerDatabase(v.(string))
+		}
+
+		if v, ok := d.GetOk("aws_privatelink"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.SQLServerAWSPrivateLink(conn)
+		}
+
+		if v, ok := d.GetOk("ssh_tunnel"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.SQLServerSSHTunnel(conn)
+		}
+
+		if v, ok := d.GetOk("ssl_mode"); ok {
+			b.SQLServerSSLMode(v.(string))
+		}
+
+		if v, ok := d.GetOk("ssl_certificate_authority"); ok {
+			ssl_ca := materialize.GetValueSecretStruct(v)
+			b.SQLServerSSLCertificateAuthority(ssl_ca)
+		}
+
+		if v, ok := d.GetOk("validate"); ok {
+			b.Validate(v.(bool))
+		}
+
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var connectionSshTunnelSchema = map[string]*schema.Schema{
@@ -45,9 +46,12 @@ var connectionSshTunnelSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
-	"validate":       ValidateConnectionSchema(),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"validate":         ValidateConnectionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("connection"),
+	"region":           RegionSchema(),
 }
 
 func ConnectionSshTunnel() *schema.Resource {
@@ -56,11 +60,11 @@ func ConnectionSshTunnel() *schema.Resource {
 
 		CreateContext: connectionSshTunnelCreate,
 		ReadContext:   connectionSshTunnelRead,
-		UpdateContext: connectionSshTunnelUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseConnection, materialize.ConnectionId, connectionSshTunnelUpdate),
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseConnection, materialize.ConnectionId),
 		},
 
 		Schema: connectionSshTunnelSchema,
@@ -68,13 +72,12 @@ func ConnectionSshTunnel() *schema.Resource {
 }
 
 func connectionSshTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanConnectionSshTunnel(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseConnection, materialize.ConnectionId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -82,7 +85,15 @@ func connectionSshTunnelRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanConnectionSshTunnel(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.ConnectionName.String))
 
 	if err := d.Set("name", s.ConnectionName.String); err != nil {
 		return diag.FromErr(err)
@@ -130,29 +141,22 @@ func connectionSshTunnelCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: connectionName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewConnectionSshTunnelBuilder(metaDb, o)
 
-	b.SSHHost(d.Get("host").(string))
-	b.SSHUser(d.Get("user").(string))
-	b.SSHPort(d.Get("port").(int))
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewConnectionSshTunnelBuilder(ctx, db, o)
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		b.SSHHost(d.Get("host").(string))
+		b.SSHUser(d.Get("user").(string))
+		b.SSHPort(d.Get("port").(int))
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ConnectionId(metaDb, o)
+	i, err := materialize.ConnectionId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -176,7 +180,7 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseConnection, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewConnectionSshTunnelBuilder(metaDb, o)
+		b := materialize.NewConnectionSshTunnelBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -184,7 +188,7 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -192,7 +196,7 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -201,7 +205,7 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("host") {
 		oldHost, newHost := d.GetChange("host")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"HOST": newHost,
 		}
@@ -213,7 +217,7 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("user") {
 		oldUser, newUser := d.GetChange("user")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"USER": newUser,
 		}
@@ -225,7 +229,7 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("port") {
 		oldPort, newPort := d.GetChange("port")
-		b := materialize.NewConnection(metaDb, o)
+		b := materialize.NewConnection(ctx, metaDb, o)
 		options := map[string]interface{}{
 			"PORT": newPort,
 		}
@@ -9,13 +9,17 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var databaseSchema = map[string]*schema.Schema{
-	"name":           ObjectNameSchema("database", true, true),
-	"comment":        CommentSchema(false),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"name":             ObjectNameSchema("database", true, true),
+	"comment":          CommentSchema(false),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("database"),
+	"region":           RegionSchema(),
 }
 
 func Database() *schema.Resource {
@@ -26,26 +30,29 @@ func Database() *schema.Resource {
 
 		CreateContext: databaseCreate,
 		ReadContext:   databaseRead,
-		UpdateContext: databaseUpdate,
+		UpdateContext: updateByCatalogId(materialize.Database, materialize.DatabaseId, databaseUpdate),
 		DeleteContext: databaseDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: databaseImport,
 		},
 
 		Schema: databaseSchema,
 	}
 }
 
-func databaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
+func databaseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("identify_by_name", isNameId(d.Id()))
+	return []*schema.ResourceData{d}, nil
+}
 
+func databaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	s, err := materialize.ScanDatabase(metaDb, utils.ExtractId(i))
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Database, materialize.DatabaseId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -53,7 +60,15 @@ func databaseRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanDatabase(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String))
 
 	if err := d.Set("name", s.DatabaseName.String); err != nil {
 		return diag.FromErr(err)
@@ -79,30 +94,18 @@ func databaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Database, Name: databaseName}
-	b := materialize.NewDatabaseBuilder(metaDb, o)
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// drop public schema by default
-	if err := b.DropPublicSchema(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
-
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		return materialize.NewDatabaseBuilder(ctx, db, o), nil
+	}, func(db *sqlx.DB) error {
+		// drop public schema by default
+		return materialize.NewDatabaseBuilder(ctx, db, o).DropPublicSchema()
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.DatabaseId(metaDb, o)
+	i, err := materialize.DatabaseId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,7 +123,7 @@ func databaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Database, Name: databaseName}
-	b := materialize.NewOwnershipBuilder(metaDb, o)
+	b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
@@ -131,7 +134,7 @@ func databaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -150,7 +153,7 @@ func databaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	o := materialize.MaterializeObject{Name: databaseName}
-	b := materialize.NewDatabaseBuilder(metaDb, o)
+	b := materialize.NewDatabaseBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
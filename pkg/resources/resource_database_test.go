@@ -71,6 +71,30 @@ func TestResourceDatabaseReadIdMigration(t *testing.T) {
 	})
 }
 
+func TestResourceDatabaseReadIdentifyByName(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "database",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, Database().Schema, in)
+	d.SetId(`aws/us-east-1:name:"database"`)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_databases.name = 'database'`
+		testhelpers.MockDatabaseScan(mock, ip)
+
+		pp := `WHERE mz_databases.id = 'u1'`
+		testhelpers.MockDatabaseScan(mock, pp)
+
+		if err := databaseRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal(`aws/us-east-1:name:"database"`, d.Id())
+	})
+}
+
 func TestResourceDatabaseDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -42,5 +42,5 @@ func grantClusterCreate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func grantClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.Cluster, "cluster_name")
+	return revokeGrant(ctx, d, meta, materialize.Cluster, "cluster_name")
 }
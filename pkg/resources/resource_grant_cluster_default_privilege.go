@@ -43,7 +43,7 @@ func grantClusterDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewDefaultPrivilegeBuilder(metaDb, "CLUSTER", granteeName, targetName, privilege)
+	b := materialize.NewDefaultPrivilegeBuilder(ctx, metaDb, "CLUSTER", granteeName, targetName, privilege)
 
 	// create resource
 	if err := b.Grant(); err != nil {
@@ -51,12 +51,12 @@ func grantClusterDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// Query ids
-	gId, err := materialize.RoleId(metaDb, granteeName)
+	gId, err := materialize.RoleId(ctx, metaDb, granteeName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	tId, err := materialize.RoleId(metaDb, targetName)
+	tId, err := materialize.RoleId(ctx, metaDb, targetName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -77,7 +77,7 @@ func grantClusterDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewDefaultPrivilegeBuilder(metaDb, "CLUSTER", granteenName, targetName, privilege)
+	b := materialize.NewDefaultPrivilegeBuilder(ctx, metaDb, "CLUSTER", granteenName, targetName, privilege)
 
 	if err := b.Revoke(); err != nil {
 		return diag.FromErr(err)
@@ -54,5 +54,5 @@ func grantConnectionCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func grantConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.BaseConnection, "connection_name")
+	return revokeGrant(ctx, d, meta, materialize.BaseConnection, "connection_name")
 }
@@ -38,5 +38,5 @@ func grantConnectionDefaultPrivilegeCreate(ctx context.Context, d *schema.Resour
 }
 
 func grantConnectionDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeDefaultPrivilegeGrant(d, meta, materialize.BaseConnection)
+	return revokeDefaultPrivilegeGrant(ctx, d, meta, materialize.BaseConnection)
 }
@@ -42,5 +42,5 @@ func grantDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func grantDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.Database, "database_name")
+	return revokeGrant(ctx, d, meta, materialize.Database, "database_name")
 }
@@ -36,5 +36,5 @@ func grantDatabaseDefaultPrivilegeCreate(ctx context.Context, d *schema.Resource
 }
 
 func grantDatabaseDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeDefaultPrivilegeGrant(d, meta, materialize.Database)
+	return revokeDefaultPrivilegeGrant(ctx, d, meta, materialize.Database)
 }
@@ -55,7 +55,7 @@ func grantDefaultPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	privileges, err := materialize.ScanDefaultPrivilege(metaDb, key.objectType, key.granteeId, key.targetRoleId, key.databaseId, key.schemaId)
+	privileges, err := materialize.ScanDefaultPrivilege(ctx, metaDb, key.objectType, key.granteeId, key.targetRoleId, key.databaseId, key.schemaId)
 	if err == sql.ErrNoRows {
 		log.Printf("[WARN] grant (%s) not found, removing from state file", d.Id())
 		d.SetId("")
@@ -54,5 +54,5 @@ func grantMaterializedViewCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func grantMaterializedViewDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.MaterializedView, "materialized_view_name")
+	return revokeGrant(ctx, d, meta, materialize.MaterializedView, "materialized_view_name")
 }
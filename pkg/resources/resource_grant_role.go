@@ -74,7 +74,7 @@ func grantRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	// Scan role members
-	roles, err := materialize.ScanRolePrivilege(metaDb, key.roleId, key.memberId)
+	roles, err := materialize.ScanRolePrivilege(ctx, metaDb, key.roleId, key.memberId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -104,18 +104,18 @@ func grantRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewRolePrivilegeBuilder(metaDb, roleName, memberName)
+	b := materialize.NewRolePrivilegeBuilder(ctx, metaDb, roleName, memberName)
 
 	if err := b.Grant(); err != nil {
 		return diag.FromErr(err)
 	}
 
-	rId, err := materialize.RoleId(metaDb, roleName)
+	rId, err := materialize.RoleId(ctx, metaDb, roleName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	mId, err := materialize.RoleId(metaDb, memberName)
+	mId, err := materialize.RoleId(ctx, metaDb, memberName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -135,7 +135,7 @@ func grantRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewRolePrivilegeBuilder(metaDb, roleName, memberName)
+	b := materialize.NewRolePrivilegeBuilder(ctx, metaDb, roleName, memberName)
 
 	if err := b.Revoke(); err != nil {
 		return diag.FromErr(err)
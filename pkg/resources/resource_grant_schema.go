@@ -48,5 +48,5 @@ func grantSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func grantSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.Schema, "schema_name")
+	return revokeGrant(ctx, d, meta, materialize.Schema, "schema_name")
 }
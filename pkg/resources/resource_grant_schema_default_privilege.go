@@ -37,5 +37,5 @@ func grantSchemaDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func grantSchemaDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeDefaultPrivilegeGrant(d, meta, materialize.Schema)
+	return revokeDefaultPrivilegeGrant(ctx, d, meta, materialize.Schema)
 }
@@ -54,5 +54,5 @@ func grantSecretCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func grantSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.Secret, "secret_name")
+	return revokeGrant(ctx, d, meta, materialize.Secret, "secret_name")
 }
@@ -38,5 +38,5 @@ func grantSecretDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func grantSecretDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeDefaultPrivilegeGrant(d, meta, materialize.Secret)
+	return revokeDefaultPrivilegeGrant(ctx, d, meta, materialize.Secret)
 }
@@ -54,5 +54,5 @@ func grantSourceCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func grantSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.BaseSource, "source_name")
+	return revokeGrant(ctx, d, meta, materialize.BaseSource, "source_name")
 }
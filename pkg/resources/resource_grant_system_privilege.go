@@ -72,7 +72,7 @@ func grantSystemPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	}
 
-	p, err := materialize.ScanSystemPrivileges(metaDb)
+	p, err := materialize.ScanSystemPrivileges(ctx, metaDb)
 	if err == sql.ErrNoRows {
 		log.Printf("[WARN] grant (%s) not found, removing from state file", d.Id())
 		d.SetId("")
@@ -110,13 +110,13 @@ func grantSystemPrivilegeCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewSystemPrivilegeBuilder(metaDb, roleName, privilege)
+	b := materialize.NewSystemPrivilegeBuilder(ctx, metaDb, roleName, privilege)
 
 	if err := b.Grant(); err != nil {
 		return diag.FromErr(err)
 	}
 
-	rId, err := materialize.RoleId(metaDb, roleName)
+	rId, err := materialize.RoleId(ctx, metaDb, roleName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -136,7 +136,7 @@ func grantSystemPrivilegeDelete(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewSystemPrivilegeBuilder(metaDb, roleName, privilege)
+	b := materialize.NewSystemPrivilegeBuilder(ctx, metaDb, roleName, privilege)
 
 	if err := b.Revoke(); err != nil {
 		return diag.FromErr(err)
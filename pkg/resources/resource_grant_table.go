@@ -54,5 +54,5 @@ func grantTableCreate(ctx context.Context, d *schema.ResourceData, meta interfac
 }
 
 func grantTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.Table, "table_name")
+	return revokeGrant(ctx, d, meta, materialize.Table, "table_name")
 }
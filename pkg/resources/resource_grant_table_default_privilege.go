@@ -38,5 +38,5 @@ func grantTableDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func grantTableDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeDefaultPrivilegeGrant(d, meta, materialize.Table)
+	return revokeDefaultPrivilegeGrant(ctx, d, meta, materialize.Table)
 }
@@ -54,5 +54,5 @@ func grantTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface
 }
 
 func grantTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.BaseType, "type_name")
+	return revokeGrant(ctx, d, meta, materialize.BaseType, "type_name")
 }
@@ -38,5 +38,5 @@ func grantTypeDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func grantTypeDefaultPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeDefaultPrivilegeGrant(d, meta, materialize.BaseType)
+	return revokeDefaultPrivilegeGrant(ctx, d, meta, materialize.BaseType)
 }
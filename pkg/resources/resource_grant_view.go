@@ -53,5 +53,5 @@ func grantViewCreate(ctx context.Context, d *schema.ResourceData, meta interface
 }
 
 func grantViewDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return revokeGrant(d, meta, materialize.View, "view_name")
+	return revokeGrant(ctx, d, meta, materialize.View, "view_name")
 }
@@ -2,8 +2,13 @@ package resources
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,18 +21,126 @@ type Droppable interface {
 	Drop() error
 }
 
+// Creatable is an interface for builders that create the object of a resource
+type Creatable interface {
+	Droppable
+	Create() error
+}
+
+// createObject creates the object of a resource and applies its ownership and
+// comment, followed by any further configure statements. The builder is
+// returned by newBuilder for the connection the statements should run on.
+//
+// The statements run one by one and the object is dropped again if configuring
+// it fails.
+func createObject(ctx context.Context, d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject, newBuilder func(conn *sqlx.DB) (Creatable, error), configure ...func(conn *sqlx.DB) error) diag.Diagnostics {
+	b, err := newBuilder(metaDb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := applyOwnership(ctx, d, metaDb, o, b); diags != nil {
+		return diags
+	}
+
+	if diags := applyComment(ctx, d, metaDb, o, b); diags != nil {
+		return diags
+	}
+
+	for _, c := range configure {
+		if err := c(metaDb); err != nil {
+			log.Printf("[DEBUG] resource failed configuration, dropping object: %s", o.Name)
+			return dropAfterFailure(o, b, err)
+		}
+	}
+
+	return nil
+}
+
+// createObjectInTransaction is createObject with all statements in a single
+// transaction, so that a failure leaves nothing behind. It is only used for
+// objects Materialize is known to create in a transaction block, tables,
+// views, materialized views and types. If Materialize still refuses the
+// transaction, the statements run again with createObject.
+func createObjectInTransaction(ctx context.Context, d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject, newBuilder func(conn *sqlx.DB) (Creatable, error), configure ...func(conn *sqlx.DB) error) diag.Diagnostics {
+	err := materialize.InTransaction(ctx, metaDb, func(tx *sqlx.DB) error {
+		b, err := newBuilder(tx)
+		if err != nil {
+			return err
+		}
+		if err := b.Create(); err != nil {
+			return err
+		}
+		if err := alterOwnership(ctx, d, tx, o); err != nil {
+			return err
+		}
+		if err := commentObject(ctx, d, tx, o); err != nil {
+			return err
+		}
+		for _, c := range configure {
+			if err := c(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if !errors.Is(err, materialize.ErrTransactionNotSupported) {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] creating %s without a transaction: %s", o.Name, err)
+
+	return createObject(ctx, d, metaDb, o, newBuilder, configure...)
+}
+
+// dropAfterFailure drops an object that was created but could not be
+// configured. If the drop fails as well, the object is left behind half
+// configured, which is reported so that it can be cleaned up by hand.
+func dropAfterFailure(o materialize.MaterializeObject, builder Droppable, err error) diag.Diagnostics {
+	diags := diag.FromErr(err)
+	if dropErr := builder.Drop(); dropErr != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to drop %s after its creation failed", o.Name),
+			Detail: fmt.Sprintf("The object was created but could not be configured or dropped again: %s. "+
+				"Drop it or import it into the state before applying again.", dropErr),
+		})
+	}
+	return diags
+}
+
+func alterOwnership(ctx context.Context, d *schema.ResourceData, conn *sqlx.DB, o materialize.MaterializeObject) error {
+	v, ok := d.GetOk("ownership_role")
+	if !ok {
+		return nil
+	}
+
+	// Objects created with execute_as_role are already owned by it
+	if role, ok := d.GetOk("execute_as_role"); ok && role.(string) == v.(string) {
+		return nil
+	}
+
+	return materialize.NewOwnershipBuilder(ctx, conn, o).Alter(v.(string))
+}
+
+func commentObject(ctx context.Context, d *schema.ResourceData, conn *sqlx.DB, o materialize.MaterializeObject) error {
+	v, ok := d.GetOk("comment")
+	if !ok {
+		return nil
+	}
+
+	return materialize.NewCommentBuilder(ctx, conn, o).Object(v.(string))
+}
+
 // applyOwnership applies ownership to a newly created resource.
 // If the operation fails, it drops the resource and returns an error.
 // This is a common pattern across connection, source, table, view, and other resources.
-func applyOwnership(d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject, builder Droppable) diag.Diagnostics {
-	if v, ok := d.GetOk("ownership_role"); ok {
-		ownership := materialize.NewOwnershipBuilder(metaDb, o)
-
-		if err := ownership.Alter(v.(string)); err != nil {
-			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
-			builder.Drop()
-			return diag.FromErr(err)
-		}
+func applyOwnership(ctx context.Context, d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject, builder Droppable) diag.Diagnostics {
+	if err := alterOwnership(ctx, d, metaDb, o); err != nil {
+		log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+		return dropAfterFailure(o, builder, err)
 	}
 
 	return nil
@@ -36,18 +149,126 @@ func applyOwnership(d *schema.ResourceData, metaDb *sqlx.DB, o materialize.Mater
 // applyComment applies a comment to a newly created resource.
 // If the operation fails, it drops the resource and returns an error.
 // This is a common pattern across connection, source, table, view, and other resources.
-func applyComment(d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject, builder Droppable) diag.Diagnostics {
-	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
+func applyComment(ctx context.Context, d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject, builder Droppable) diag.Diagnostics {
+	if err := commentObject(ctx, d, metaDb, o); err != nil {
+		log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+		return dropAfterFailure(o, builder, err)
+	}
+
+	return nil
+}
+
+// importByName returns an importer for schema-scoped objects. In addition to
+// the catalog ID it accepts `region:name:database.schema.object`. As with
+// clusters and schemas, importing by name sets identify_by_name, and the name
+// is resolved with lookup to check the object exists.
+func importByName(objectType materialize.EntityType, lookup func(context.Context, *sqlx.DB, materialize.MaterializeObject) (string, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		identifyByName := isNameId(d.Id())
+		d.Set("identify_by_name", identifyByName)
+
+		if !identifyByName {
+			return []*schema.ResourceData{d}, nil
+		}
 
-		if err := comment.Object(v.(string)); err != nil {
-			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			builder.Drop()
-			return diag.FromErr(err)
+		o, err := parseNameId(d.Id(), objectType)
+		if err != nil {
+			return nil, err
 		}
+
+		metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := lookup(ctx, metaDb, o); err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%s %s does not exist", strings.ToLower(string(objectType)), o.QualifiedName())
+		} else if err != nil {
+			return nil, fmt.Errorf("error importing %s: %w", o.QualifiedName(), err)
+		}
+
+		d.SetId(utils.TransformIdWithTypeAndRegion(string(region), "name", o.QualifiedName()))
+		return []*schema.ResourceData{d}, nil
 	}
+}
 
-	return nil
+// isNameId reports whether a state ID identifies the object by name. Names
+// may contain colons, so only the region and ID type are split off.
+func isNameId(fullId string) bool {
+	parts := strings.SplitN(fullId, ":", 3)
+	return len(parts) == 3 && parts[1] == "name"
+}
+
+// parseNameId returns the object a `region:name:name` ID refers to. The name
+// is qualified as with QualifiedName, `database.schema.object`, or only the
+// database name for databases. The `database|schema|object` form, matching
+// the IDs of materialize_schema, is accepted as well.
+func parseNameId(fullId string, objectType materialize.EntityType) (materialize.MaterializeObject, error) {
+	parts := strings.SplitN(fullId, ":", 3)
+	fields, err := materialize.ParseNameId(parts[2], 3)
+	if err != nil {
+		return materialize.MaterializeObject{}, err
+	}
+
+	o := materialize.MaterializeObject{ObjectType: objectType}
+	if objectType == materialize.Database {
+		if len(fields) != 1 {
+			return o, fmt.Errorf("%s cannot be parsed correctly, expected region:name:database", fullId)
+		}
+		o.Name = fields[0]
+		return o, nil
+	}
+
+	if len(fields) != 3 {
+		return o, fmt.Errorf("%s cannot be parsed correctly, expected region:name:database.schema.object", fullId)
+	}
+	o.DatabaseName, o.SchemaName, o.Name = fields[0], fields[1], fields[2]
+	return o, nil
+}
+
+// catalogId returns the catalog ID of the object a state ID refers to. State
+// IDs are either `region:id` or, with identify_by_name, `region:name:name`
+// where name is the qualified name of the object, which is resolved with
+// lookup.
+func catalogId(ctx context.Context, metaDb *sqlx.DB, fullId string, objectType materialize.EntityType, lookup func(context.Context, *sqlx.DB, materialize.MaterializeObject) (string, error)) (string, error) {
+	if !isNameId(fullId) {
+		return utils.ExtractId(fullId), nil
+	}
+
+	o, err := parseNameId(fullId, objectType)
+	if err != nil {
+		return "", err
+	}
+	return lookup(ctx, metaDb, o)
+}
+
+// nameStateId returns the ID to store in state for an object, which is the
+// qualified name when identify_by_name is set and the catalog ID otherwise.
+func nameStateId(d *schema.ResourceData, region clients.Region, catalogId string, qualifiedName ...string) string {
+	if d.Get("identify_by_name").(bool) {
+		return utils.TransformIdWithTypeAndRegion(string(region), "name", materialize.QualifiedName(qualifiedName...))
+	}
+	return utils.TransformIdWithRegion(string(region), catalogId)
+}
+
+// updateByCatalogId replaces a name based state ID with the catalog ID before
+// running update, so that the read at the end of an update that renames the
+// object looks it up by ID and stores the new name.
+func updateByCatalogId(objectType materialize.EntityType, lookup func(context.Context, *sqlx.DB, materialize.MaterializeObject) (string, error), update schema.UpdateContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if isNameId(d.Id()) {
+			metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			i, err := catalogId(ctx, metaDb, d.Id(), objectType, lookup)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(utils.TransformIdWithRegion(string(region), i))
+		}
+		return update(ctx, d, meta)
+	}
 }
 
 // createGrant creates a grant for a given object type.
@@ -83,7 +304,7 @@ func createGrant(ctx context.Context, d *schema.ResourceData, meta interface{},
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewPrivilegeBuilder(metaDb, roleName, privilege, obj)
+	b := materialize.NewPrivilegeBuilder(ctx, metaDb, roleName, privilege, obj)
 
 	// grant resource
 	if err := b.Grant(); err != nil {
@@ -91,12 +312,12 @@ func createGrant(ctx context.Context, d *schema.ResourceData, meta interface{},
 	}
 
 	// set grant id
-	roleId, err := materialize.RoleId(metaDb, roleName)
+	roleId, err := materialize.RoleId(ctx, metaDb, roleName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	i, err := materialize.ObjectId(metaDb, obj)
+	i, err := materialize.ObjectId(ctx, metaDb, obj)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,7 +330,7 @@ func createGrant(ctx context.Context, d *schema.ResourceData, meta interface{},
 
 // revokeGrant revokes a grant for a given object type.
 // This is the common pattern used across all grant resources (cluster, database, schema, etc.).
-func revokeGrant(d *schema.ResourceData, meta interface{}, objectType materialize.EntityType, objectNameField string) diag.Diagnostics {
+func revokeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, objectType materialize.EntityType, objectNameField string) diag.Diagnostics {
 	roleName := d.Get("role_name").(string)
 	privilege := d.Get("privilege").(string)
 	objectName := d.Get(objectNameField).(string)
@@ -140,7 +361,7 @@ func revokeGrant(d *schema.ResourceData, meta interface{}, objectType materializ
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewPrivilegeBuilder(metaDb, roleName, privilege, obj)
+	b := materialize.NewPrivilegeBuilder(ctx, metaDb, roleName, privilege, obj)
 
 	if err := b.Revoke(); err != nil {
 		return diag.FromErr(err)
@@ -161,7 +382,7 @@ func createDefaultPrivilegeGrant(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewDefaultPrivilegeBuilder(metaDb, objectType, granteeName, targetName, privilege)
+	b := materialize.NewDefaultPrivilegeBuilder(ctx, metaDb, objectType, granteeName, targetName, privilege)
 
 	// create resource
 	if err := b.Grant(); err != nil {
@@ -169,12 +390,12 @@ func createDefaultPrivilegeGrant(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Query ids
-	gId, err := materialize.RoleId(metaDb, granteeName)
+	gId, err := materialize.RoleId(ctx, metaDb, granteeName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	tId, err := materialize.RoleId(metaDb, targetName)
+	tId, err := materialize.RoleId(ctx, metaDb, targetName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -187,7 +408,7 @@ func createDefaultPrivilegeGrant(ctx context.Context, d *schema.ResourceData, me
 
 // revokeDefaultPrivilegeGrant revokes a default privilege grant for a given object type.
 // This is the common pattern used across all default privilege grant resources.
-func revokeDefaultPrivilegeGrant(d *schema.ResourceData, meta interface{}, objectType materialize.EntityType) diag.Diagnostics {
+func revokeDefaultPrivilegeGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, objectType materialize.EntityType) diag.Diagnostics {
 	granteeName := d.Get("grantee_name").(string)
 	targetName := d.Get("target_role_name").(string)
 	privilege := d.Get("privilege").(string)
@@ -197,7 +418,7 @@ func revokeDefaultPrivilegeGrant(d *schema.ResourceData, meta interface{}, objec
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewDefaultPrivilegeBuilder(metaDb, objectType, granteeName, targetName, privilege)
+	b := materialize.NewDefaultPrivilegeBuilder(ctx, metaDb, objectType, granteeName, targetName, privilege)
 
 	if err := b.Revoke(); err != nil {
 		return diag.FromErr(err)
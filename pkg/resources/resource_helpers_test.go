@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyOwnershipExecuteAsRole(t *testing.T) {
+	r := require.New(t)
+	o := materialize.MaterializeObject{ObjectType: materialize.View, Name: "view", SchemaName: "schema", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// The object was created by the role, so it already owns it
+		in := map[string]interface{}{"ownership_role": "analyst", "execute_as_role": "analyst"}
+		d := schema.TestResourceDataRaw(t, View().Schema, in)
+		r.Nil(applyOwnership(context.Background(), d, db, o, materialize.NewViewBuilder(context.Background(), db, o)))
+
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		in = map[string]interface{}{"ownership_role": "joe", "execute_as_role": "analyst"}
+		d = schema.TestResourceDataRaw(t, View().Schema, in)
+		r.Nil(applyOwnership(context.Background(), d, db, o, materialize.NewViewBuilder(context.Background(), db, o)))
+	})
+}
+
+func TestSessionOptionsSchemaReservedKey(t *testing.T) {
+	r := require.New(t)
+	s := SessionOptionsSchema()
+
+	r.False(s.ValidateDiagFunc(map[string]interface{}{"cluster": "c", "search_path": "a, b"}, nil).HasError())
+	r.True(s.ValidateDiagFunc(map[string]interface{}{"transaction_isolation": "serializable"}, nil).HasError())
+}
+
+func newViewBuilder(o materialize.MaterializeObject) func(db *sqlx.DB) (Creatable, error) {
+	return func(db *sqlx.DB) (Creatable, error) {
+		return materialize.NewViewBuilder(context.Background(), db, o).SelectStmt("SELECT 1"), nil
+	}
+}
+
+func TestCreateObjectInTransactionRollsBack(t *testing.T) {
+	r := require.New(t)
+	o := materialize.MaterializeObject{ObjectType: materialize.View, Name: "view", SchemaName: "schema", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE VIEW "database"."schema"."view" AS SELECT 1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON VIEW "database"."schema"."view" IS 'comment';`).WillReturnError(errors.New("comment failed"))
+		mock.ExpectRollback()
+
+		// Nothing is left behind, so there is nothing to drop
+		in := map[string]interface{}{"ownership_role": "joe", "comment": "comment"}
+		d := schema.TestResourceDataRaw(t, View().Schema, in)
+		diags := createObjectInTransaction(context.Background(), d, db, o, newViewBuilder(o))
+		r.True(diags.HasError())
+		r.Len(diags, 1)
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateObjectInTransactionNotSupported(t *testing.T) {
+	r := require.New(t)
+	o := materialize.MaterializeObject{ObjectType: materialize.View, Name: "view", SchemaName: "schema", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE VIEW`).WillReturnError(&pgconn.PgError{Severity: "ERROR", Code: "25001", Message: "CREATE VIEW cannot be run inside a transaction block"})
+		mock.ExpectRollback()
+
+		// The statements run again one by one
+		mock.ExpectExec(`CREATE VIEW "database"."schema"."view" AS SELECT 1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		in := map[string]interface{}{"ownership_role": "joe"}
+		d := schema.TestResourceDataRaw(t, View().Schema, in)
+		r.Nil(createObjectInTransaction(context.Background(), d, db, o, newViewBuilder(o)))
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateObjectInTransactionBeginFailure(t *testing.T) {
+	r := require.New(t)
+	o := materialize.MaterializeObject{ObjectType: materialize.View, Name: "view", SchemaName: "schema", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// The statements are not run again without a transaction
+		mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+
+		d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{})
+		diags := createObjectInTransaction(context.Background(), d, db, o, newViewBuilder(o))
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "connection refused")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateObjectDropFailure(t *testing.T) {
+	r := require.New(t)
+	o := materialize.MaterializeObject{ObjectType: materialize.View, Name: "view", SchemaName: "schema", DatabaseName: "database"}
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE VIEW`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" OWNER TO "joe";`).WillReturnError(errors.New("ownership failed"))
+		mock.ExpectExec(`DROP VIEW "database"."schema"."view";`).WillReturnError(errors.New("drop failed"))
+
+		in := map[string]interface{}{"ownership_role": "joe"}
+		d := schema.TestResourceDataRaw(t, View().Schema, in)
+		diags := createObject(context.Background(), d, db, o, newViewBuilder(o))
+		r.Len(diags, 2)
+		r.Equal("ownership failed", diags[0].Summary)
+		r.Equal("Unable to drop view after its creation failed", diags[1].Summary)
+		r.Contains(diags[1].Detail, "drop failed")
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
@@ -79,7 +79,8 @@ var indexSchema = map[string]*schema.Schema{
 		Computed:      true,
 		ForceNew:      true,
 	},
-	"region": RegionSchema(),
+	"identify_by_name": IdentifyByNameSchema("index"),
+	"region":           RegionSchema(),
 }
 
 func Index() *schema.Resource {
@@ -88,11 +89,11 @@ func Index() *schema.Resource {
 
 		CreateContext: indexCreate,
 		ReadContext:   indexRead,
-		UpdateContext: indexUpdate,
+		UpdateContext: updateByCatalogId(materialize.Index, materialize.QualifiedIndexId, indexUpdate),
 		DeleteContext: indexDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.Index, materialize.QualifiedIndexId),
 		},
 
 		Schema: indexSchema,
@@ -100,13 +101,20 @@ func Index() *schema.Resource {
 }
 
 func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanIndex(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Index, materialize.QualifiedIndexId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanIndex(ctx, metaDb, i)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -114,7 +122,7 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	d.SetId(nameStateId(d, region, i, s.ObjectDatabaseName.String, s.ObjectSchemaName.String, s.IndexName.String))
 
 	if err := d.Set("name", s.IndexName.String); err != nil {
 		return diag.FromErr(err)
@@ -138,7 +146,7 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	}
 
 	// Get and set the index columns
-	indexColumns, err := materialize.ListIndexColumns(metaDb, utils.ExtractId(i))
+	indexColumns, err := materialize.ListIndexColumns(ctx, metaDb, utils.ExtractId(i))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -176,6 +184,7 @@ func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Index, Name: indexName}
 	b := materialize.NewIndexBuilder(
+		ctx,
 		metaDb,
 		o,
 		indexDefault,
@@ -208,8 +217,7 @@ func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("comment"); ok {
 		if err := b.Comment(v.(string)); err != nil {
 			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+			return dropAfterFailure(o, b, err)
 		}
 	}
 
@@ -217,6 +225,7 @@ func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if indexDefault {
 		// For default indexes, find the index by the object it's on
 		idxParams, err := materialize.FindDefaultIndexByObject(
+			ctx,
 			metaDb,
 			obj["name"].(string),
 			obj["schema_name"].(string),
@@ -235,7 +244,7 @@ func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		d.SetId(utils.TransformIdWithRegion(string(region), idxParams.IndexId.String))
 	} else {
 		// Original code for named indexes
-		i, err := materialize.IndexId(metaDb, indexName)
+		i, err := materialize.IndexId(ctx, metaDb, indexName)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -256,7 +265,7 @@ func indexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -277,6 +286,7 @@ func indexDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Index, Name: name}
 	b := materialize.NewIndexBuilder(
+		ctx,
 		metaDb,
 		o,
 		d.Get("default").(bool),
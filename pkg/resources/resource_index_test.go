@@ -136,3 +136,19 @@ func TestResourceIndexDefaultCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceIndexImportByName(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Index().Schema, map[string]interface{}{})
+	d.SetId("aws/us-east-1:name:database.schema.index")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockIndexScan(mock, ip)
+
+		res, err := Index().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Equal(`aws/us-east-1:name:"database"."schema"."index"`, res[0].Id())
+		r.Equal(true, res[0].Get("identify_by_name"))
+	})
+}
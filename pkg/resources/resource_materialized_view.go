@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var GrantDefinition = "Manages the privileges on a Materailize %[1]s for roles."
@@ -43,8 +44,11 @@ var materializedViewSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("materialized view"),
+	"region":           RegionSchema(),
 }
 
 func MaterializedView() *schema.Resource {
@@ -53,11 +57,11 @@ func MaterializedView() *schema.Resource {
 
 		CreateContext: materializedViewCreate,
 		ReadContext:   materializedViewRead,
-		UpdateContext: materializedViewUpdate,
+		UpdateContext: updateByCatalogId(materialize.MaterializedView, materialize.MaterializedViewId, materializedViewUpdate),
 		DeleteContext: materializedViewDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.MaterializedView, materialize.MaterializedViewId),
 		},
 
 		Schema: materializedViewSchema,
@@ -65,13 +69,12 @@ func MaterializedView() *schema.Resource {
 }
 
 func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanMaterializedView(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.MaterializedView, materialize.MaterializedViewId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -79,7 +82,15 @@ func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanMaterializedView(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.MaterializedViewName.String))
 
 	if err := d.Set("name", s.MaterializedViewName.String); err != nil {
 		return diag.FromErr(err)
@@ -127,41 +138,34 @@ func materializedViewCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.MaterializedView, Name: materializedViewName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewMaterializedViewBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok && v.(string) != "" {
-		b.ClusterName(v.(string))
-	}
+	// create resource with its ownership and comment
+	if diags := createObjectInTransaction(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewMaterializedViewBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("not_null_assertion"); ok && len(v.([]interface{})) > 0 {
-		nas, err := materialize.GetSliceValueString("not_null_assertion", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("cluster_name"); ok && v.(string) != "" {
+			b.ClusterName(v.(string))
 		}
-		b.NotNullAssertions(nas)
-	}
 
-	if v, ok := d.GetOk("statement"); ok && v.(string) != "" {
-		b.SelectStmt(v.(string))
-	}
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("not_null_assertion"); ok && len(v.([]interface{})) > 0 {
+			nas, err := materialize.GetSliceValueString("not_null_assertion", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.NotNullAssertions(nas)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("statement"); ok && v.(string) != "" {
+			b.SelectStmt(v.(string))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.MaterializedViewId(metaDb, o)
+	i, err := materialize.MaterializedViewId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -184,7 +188,7 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("name") {
 		oldName, newMaterializedViewName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.MaterializedView, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewMaterializedViewBuilder(metaDb, o)
+		b := materialize.NewMaterializedViewBuilder(ctx, metaDb, o)
 		if err := b.Rename(newMaterializedViewName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -192,7 +196,7 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -201,7 +205,7 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -221,7 +225,7 @@ func materializedViewDelete(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: materializedViewName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewMaterializedViewBuilder(metaDb, o)
+	b := materialize.NewMaterializedViewBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
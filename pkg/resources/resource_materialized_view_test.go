@@ -28,9 +28,11 @@ func TestResourceMaterializedViewCreate(t *testing.T) {
 
 	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
 		// Create
+		mock.ExpectBegin()
 		mock.ExpectExec(
 			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view" IN CLUSTER "cluster" WITH \(ASSERT NOT NULL "column_1", ASSERT NOT NULL "column_2"\) AS SELECT 1 FROM 1;`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
 
 		// Query Id
 		ip := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view' AND mz_schemas.name = 'schema'`
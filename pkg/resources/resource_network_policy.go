@@ -93,7 +93,7 @@ func networkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
-	policy, err := materialize.ScanNetworkPolicy(metaDb, utils.ExtractId(i))
+	policy, err := materialize.ScanNetworkPolicy(ctx, metaDb, utils.ExtractId(i))
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -141,7 +141,7 @@ func networkPolicyCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Name:       name,
 	}
 
-	b := materialize.NewNetworkPolicyBuilder(metaDb, o)
+	b := materialize.NewNetworkPolicyBuilder(ctx, metaDb, o)
 
 	// Convert rules from terraform format
 	if v, ok := d.GetOk("rule"); ok {
@@ -165,15 +165,14 @@ func networkPolicyCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	// comment
 	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
+		comment := materialize.NewCommentBuilder(ctx, metaDb, o)
 		if err := comment.Object(v.(string)); err != nil {
 			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+			return dropAfterFailure(o, b, err)
 		}
 	}
 
-	i, err := materialize.NetworkPolicyId(metaDb, o)
+	i, err := materialize.NetworkPolicyId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -197,7 +196,7 @@ func networkPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if d.HasChange("rule") {
-		b := materialize.NewNetworkPolicyBuilder(metaDb, o)
+		b := materialize.NewNetworkPolicyBuilder(ctx, metaDb, o)
 		v := d.Get("rule")
 		rules := v.(*schema.Set).List()
 		policyRules := make([]materialize.NetworkPolicyRule, len(rules))
@@ -218,7 +217,7 @@ func networkPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -237,7 +236,7 @@ func networkPolicyDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	o := materialize.MaterializeObject{Name: name}
-	b := materialize.NewNetworkPolicyBuilder(metaDb, o)
+	b := materialize.NewNetworkPolicyBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,207 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var networkPolicyAttachmentSchema = map[string]*schema.Schema{
+	"network_policy_name": {
+		Description: "The name of the network policy to activate.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"role_name": {
+		Description: "The role to attach the network policy to. If not set, the network policy becomes the system default for every role without a network policy of its own.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"region": RegionSchema(),
+}
+
+func NetworkPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Activates a network policy as the system default or for a specific role. " +
+			"Before attaching, the provider checks that the policy allows the address of its own session, " +
+			"so that applying the change cannot lock Terraform out. " +
+			"If Materialize does not report the address, the check is skipped with a warning.",
+
+		CreateContext: networkPolicyAttachmentCreate,
+		ReadContext:   networkPolicyAttachmentRead,
+		UpdateContext: networkPolicyAttachmentUpdate,
+		DeleteContext: networkPolicyAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: networkPolicyAttachmentImport,
+		},
+
+		Schema: networkPolicyAttachmentSchema,
+	}
+}
+
+func networkPolicyAttachmentKey(roleName string) string {
+	if roleName == "" {
+		return "SYSTEM"
+	}
+	return "ROLE|" + roleName
+}
+
+// checkNetworkPolicyAttachment verifies that the policy exists and that it
+// allows the current session, if the session would be subject to it. A warning
+// is returned when the client address is unknown and the check is skipped.
+func checkNetworkPolicyAttachment(ctx context.Context, metaDb *sqlx.DB, policyName, roleName string) diag.Diagnostics {
+	o := materialize.MaterializeObject{ObjectType: materialize.NetworkPolicy, Name: policyName}
+	id, err := materialize.NetworkPolicyId(ctx, metaDb, o)
+	if err == sql.ErrNoRows {
+		return diag.Errorf("network policy %s does not exist", policyName)
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	address, user, err := materialize.SessionClientAddress(ctx, metaDb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if address == "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Unable to check that network policy %s allows this session", policyName),
+			Detail:   "Materialize did not report the client address of the session, so the provider could not check that the policy keeps allowing it. Make sure the policy allows the address Terraform connects from.",
+		}}
+	}
+	if roleName != "" && roleName != user {
+		return nil
+	}
+
+	policy, err := materialize.ScanNetworkPolicy(ctx, metaDb, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowed, err := materialize.NetworkPolicyAllows(policy.Rules, address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !allowed {
+		return diag.Errorf("network policy %s does not allow the current client address %s, attaching it would lock out this session", policyName, address)
+	}
+	return nil
+}
+
+func networkPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyName, err := materialize.ScanNetworkPolicyAttachment(ctx, metaDb, roleName)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), networkPolicyAttachmentKey(roleName)))
+
+	if err := d.Set("network_policy_name", policyName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func networkPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policyName := d.Get("network_policy_name").(string)
+	roleName := d.Get("role_name").(string)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := checkNetworkPolicyAttachment(ctx, metaDb, policyName, roleName)
+	if diags.HasError() {
+		return diags
+	}
+
+	b := materialize.NewNetworkPolicyAttachmentBuilder(ctx, metaDb, roleName, policyName)
+	if err := b.Attach(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), networkPolicyAttachmentKey(roleName)))
+
+	return append(diags, networkPolicyAttachmentRead(ctx, d, meta)...)
+}
+
+func networkPolicyAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.HasChange("network_policy_name") {
+		policyName := d.Get("network_policy_name").(string)
+		roleName := d.Get("role_name").(string)
+
+		metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags = checkNetworkPolicyAttachment(ctx, metaDb, policyName, roleName)
+		if diags.HasError() {
+			return diags
+		}
+
+		b := materialize.NewNetworkPolicyAttachmentBuilder(ctx, metaDb, roleName, policyName)
+		if err := b.Attach(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return append(diags, networkPolicyAttachmentRead(ctx, d, meta)...)
+}
+
+func networkPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.NewNetworkPolicyAttachmentBuilder(ctx, metaDb, roleName, "")
+	if err := b.Detach(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// networkPolicyAttachmentImport accepts region:SYSTEM or region:ROLE|<role name>.
+func networkPolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(utils.ExtractId(d.Id()), "|", 2)
+	switch {
+	case len(parts) == 1 && parts[0] == "SYSTEM":
+		if err := d.Set("role_name", ""); err != nil {
+			return nil, err
+		}
+	case len(parts) == 2 && parts[0] == "ROLE" && parts[1] != "":
+		if err := d.Set("role_name", parts[1]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%s cannot be parsed correctly, expected region:SYSTEM or region:ROLE|<role name>", d.Id())
+	}
+	return []*schema.ResourceData{d}, nil
+}
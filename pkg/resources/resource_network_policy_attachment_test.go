@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func mockSessionClientAddress(mock sqlmock.Sqlmock, address, user string) {
+	mock.ExpectQuery(`SELECT mz_sessions.client_ip::text, current_user FROM mz_internal.mz_sessions`).
+		WillReturnRows(mock.NewRows([]string{"client_ip", "current_user"}).AddRow(address, user))
+}
+
+func TestResourceNetworkPolicyAttachmentCreateSystem(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{"network_policy_name": "office_policy"}
+	d := schema.TestResourceDataRaw(t, NetworkPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE policy_name = 'office_policy'`)
+		mockSessionClientAddress(mock, "1.2.3.5/32", "mz_system")
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE policy.id = 'u1'`)
+
+		mock.ExpectExec(`ALTER SYSTEM SET "network_policy" TO 'office_policy';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectQuery(`SHOW "network_policy";`).WillReturnRows(mock.NewRows([]string{"network_policy"}).AddRow("office_policy"))
+
+		if err := networkPolicyAttachmentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:SYSTEM", d.Id())
+		r.Equal("office_policy", d.Get("network_policy_name"))
+	})
+}
+
+func TestResourceNetworkPolicyAttachmentCreateLockout(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{"network_policy_name": "office_policy"}
+	d := schema.TestResourceDataRaw(t, NetworkPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE policy_name = 'office_policy'`)
+		mockSessionClientAddress(mock, "9.9.9.9/32", "mz_system")
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE policy.id = 'u1'`)
+
+		diags := networkPolicyAttachmentCreate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "would lock out this session")
+	})
+}
+
+func TestResourceNetworkPolicyAttachmentCreateUnknownAddress(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{"network_policy_name": "office_policy"}
+	d := schema.TestResourceDataRaw(t, NetworkPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE policy_name = 'office_policy'`)
+		mock.ExpectQuery(`SELECT mz_sessions.client_ip::text, current_user FROM mz_internal.mz_sessions`).
+			WillReturnRows(mock.NewRows([]string{"client_ip", "current_user"}).AddRow(nil, "mz_system"))
+
+		mock.ExpectExec(`ALTER SYSTEM SET "network_policy" TO 'office_policy';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectQuery(`SHOW "network_policy";`).WillReturnRows(mock.NewRows([]string{"network_policy"}).AddRow("office_policy"))
+
+		// The policy is attached, the skipped check is reported as a warning
+		diags := networkPolicyAttachmentCreate(context.TODO(), d, db)
+		r.False(diags.HasError())
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+		r.Contains(diags[0].Summary, "Unable to check that network policy office_policy allows this session")
+		r.Equal("aws/us-east-1:SYSTEM", d.Id())
+	})
+}
+
+func TestResourceNetworkPolicyAttachmentCreateOtherRole(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{"network_policy_name": "office_policy", "role_name": "analyst"}
+	d := schema.TestResourceDataRaw(t, NetworkPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// The session role is not subject to the policy, so the rules are not checked
+		testhelpers.MockNetworkPolicyScan(mock, `WHERE policy_name = 'office_policy'`)
+		mockSessionClientAddress(mock, "9.9.9.9/32", "mz_system")
+
+		mock.ExpectExec(`ALTER ROLE "analyst" SET "network_policy" TO 'office_policy';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectQuery(`SELECT mz_role_parameters.parameter_value FROM mz_catalog.mz_role_parameters JOIN mz_roles ON mz_role_parameters.role_id = mz_roles.id WHERE mz_roles.name = 'analyst' AND mz_role_parameters.parameter_name = 'network_policy';`).
+			WillReturnRows(mock.NewRows([]string{"parameter_value"}).AddRow("office_policy"))
+
+		if err := networkPolicyAttachmentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:ROLE|analyst", d.Id())
+	})
+}
+
+func TestResourceNetworkPolicyAttachmentImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, NetworkPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("aws/us-east-1:ROLE|analyst")
+
+	_, err := networkPolicyAttachmentImport(context.TODO(), d, nil)
+	r.NoError(err)
+	r.Equal("analyst", d.Get("role_name"))
+
+	d.SetId("aws/us-east-1:ROLE|")
+	_, err = networkPolicyAttachmentImport(context.TODO(), d, nil)
+	r.Error(err)
+}
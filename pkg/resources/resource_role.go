@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -43,6 +44,16 @@ var roleSchema = map[string]*schema.Schema{
 		Optional:     true,
 		RequiredWith: []string{"password_wo"},
 	},
+	"password_rotation_trigger": {
+		Description: "Arbitrary value that sets the configured password again whenever it changes, such as the `id` of a `time_rotating` resource. Combine it with a password that is regenerated on the same schedule to rotate the password of the role. Works with both password and password_wo.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"password_updated_at": {
+		Description: "When the password of the role was last set. Empty if the role has no password or the Materialize version does not record it.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
 	"superuser": {
 		Description: "Whether the role is a superuser. Only available in self-hosted Materialize environments with password authentication enabled.",
 		Type:        schema.TypeBool,
@@ -89,7 +100,7 @@ func roleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
-	s, err := materialize.ScanRole(metaDb, utils.ExtractId(i))
+	s, err := materialize.ScanRole(ctx, metaDb, utils.ExtractId(i))
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -115,6 +126,18 @@ func roleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	passwordUpdatedAt, err := materialize.ScanRolePasswordUpdatedAt(ctx, metaDb, s.RoleId.String)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	updatedAt := ""
+	if passwordUpdatedAt.Valid {
+		updatedAt = passwordUpdatedAt.Time.UTC().Format(time.RFC3339)
+	}
+	if err := d.Set("password_updated_at", updatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
 	qn := materialize.QualifiedName(s.RoleName.String)
 	if err := d.Set("qualified_sql_name", qn); err != nil {
 		return diag.FromErr(err)
@@ -144,7 +167,7 @@ func roleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Role, Name: roleName}
-	b := materialize.NewRoleBuilder(metaDb, o)
+	b := materialize.NewRoleBuilder(ctx, metaDb, o)
 
 	// When create_if_not_exists is set, adopt a role that already exists (for
 	// example, one auto-provisioned by SSO/OIDC on first login) instead of
@@ -152,13 +175,13 @@ func roleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	// CREATE ROLE IF NOT EXISTS, so we probe the catalog and, if the role is
 	// present, reconcile it into state by applying the configured attributes.
 	if d.Get("create_if_not_exists").(bool) {
-		existingID, err := materialize.RoleId(metaDb, roleName)
+		existingID, err := materialize.RoleId(ctx, metaDb, roleName)
 		if err != nil && err != sql.ErrNoRows {
 			return diag.FromErr(err)
 		}
 		if err == nil && existingID != "" {
 			log.Printf("[DEBUG] role %q already exists (id %s), adopting into state", roleName, existingID)
-			if diags := roleAdopt(d, metaDb, b, o); diags != nil {
+			if diags := roleAdopt(ctx, d, metaDb, b, o); diags != nil {
 				return diags
 			}
 			d.SetId(utils.TransformIdWithRegion(string(region), existingID))
@@ -191,17 +214,16 @@ func roleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	// object comment
 	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
+		comment := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := comment.Object(v.(string)); err != nil {
 			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+			return dropAfterFailure(o, b, err)
 		}
 	}
 
 	// set id
-	i, err := materialize.RoleId(metaDb, roleName)
+	i, err := materialize.RoleId(ctx, metaDb, roleName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -229,7 +251,7 @@ func configBool(d *schema.ResourceData, key string) (value bool, set bool) {
 // create_if_not_exists is set and the role already exists in the catalog. Note
 // that inherit cannot be altered (Materialize roles are always INHERIT), so it
 // is intentionally not reconciled here.
-func roleAdopt(d *schema.ResourceData, metaDb *sqlx.DB, b *materialize.RoleBuilder, o materialize.MaterializeObject) diag.Diagnostics {
+func roleAdopt(ctx context.Context, d *schema.ResourceData, metaDb *sqlx.DB, b *materialize.RoleBuilder, o materialize.MaterializeObject) diag.Diagnostics {
 	var password string
 	if v, ok := d.GetOk("password"); ok && v.(string) != "" {
 		password = v.(string)
@@ -260,7 +282,7 @@ func roleAdopt(d *schema.ResourceData, metaDb *sqlx.DB, b *materialize.RoleBuild
 	}
 
 	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
+		comment := materialize.NewCommentBuilder(ctx, metaDb, o)
 		if err := comment.Object(v.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -279,7 +301,7 @@ func roleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewRoleBuilder(metaDb, o)
+	b := materialize.NewRoleBuilder(ctx, metaDb, o)
 
 	if d.HasChange("password") {
 		_, newPassword := d.GetChange("password")
@@ -306,21 +328,29 @@ func roleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("password_wo_version") {
-		if passwordWo, _ := d.GetRawConfigAt(cty.GetAttrPath("password_wo")); !passwordWo.IsNull() {
+	if d.HasChanges("password_wo_version", "password_rotation_trigger") {
+		if passwordWo, _ := d.GetRawConfigAt(cty.GetAttrPath("password_wo")); !passwordWo.IsNull() && passwordWo.IsKnown() {
 			if !passwordWo.Type().Equals(cty.String) {
 				return diag.Errorf("error retrieving write-only argument: password_wo - retrieved config value is not a string")
 			}
 			if err := b.AlterPassword(passwordWo.AsString()); err != nil {
 				return diag.FromErr(err)
 			}
+		} else if d.HasChange("password_rotation_trigger") && !d.HasChange("password") {
+			// The password itself did not change, set it again so the
+			// rotation is recorded
+			if password := d.Get("password").(string); password != "" {
+				if err := b.AlterPassword(password); err != nil {
+					return diag.FromErr(err)
+				}
+			}
 		}
 	}
 
@@ -336,7 +366,7 @@ func roleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Role, Name: roleName}
-	b := materialize.NewRoleBuilder(metaDb, o)
+	b := materialize.NewRoleBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
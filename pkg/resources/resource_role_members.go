@@ -0,0 +1,288 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var roleMembersSchema = map[string]*schema.Schema{
+	"role_name": {
+		Description: "The role whose members are managed.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"members": {
+		Description: "The roles that should be members of `role_name`.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+	},
+	"exclusive": {
+		Description: "If `true`, `members` is the complete list of members of `role_name` and any other member is revoked. If `false`, members granted outside of this resource are left in place and reported in `unmanaged_members`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"unmanaged_members": {
+		Description: "Members of `role_name` that are not listed in `members`. Always empty when `exclusive` is `true`.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	},
+	"region": RegionSchema(),
+}
+
+func RoleMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the set of members of a role. Unlike `materialize_role_grant`, which manages a single membership, this resource converges all memberships of a role at once.",
+
+		CreateContext: roleMembersCreate,
+		ReadContext:   roleMembersRead,
+		UpdateContext: roleMembersUpdate,
+		DeleteContext: roleMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: roleMembersSchema,
+	}
+}
+
+func roleMembersKey(region, roleId string) string {
+	return fmt.Sprintf(`%s:ROLE MEMBERS|%s`, region, roleId)
+}
+
+func parseRoleMembersKey(id string) (string, error) {
+	ie := strings.Split(utils.ExtractId(id), "|")
+
+	if len(ie) != 2 || ie[0] != "ROLE MEMBERS" {
+		return "", fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return ie[1], nil
+}
+
+func currentRoleMembers(ctx context.Context, conn *sqlx.DB, roleId string) (map[string]bool, error) {
+	members, err := materialize.ListRoleMembers(ctx, conn, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[string]bool{}
+	for _, m := range members {
+		current[m.MemberName.String] = true
+	}
+	return current, nil
+}
+
+func sortedRoleMembers(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func roleMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	roleId, err := parseRoleMembersKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, err := materialize.ScanRole(ctx, metaDb, roleId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configured := map[string]bool{}
+	for _, m := range d.Get("members").(*schema.Set).List() {
+		configured[m.(string)] = true
+	}
+
+	members := []string{}
+	unmanaged := []string{}
+	for _, m := range sortedRoleMembers(current) {
+		if d.Get("exclusive").(bool) || configured[m] {
+			members = append(members, m)
+		} else {
+			unmanaged = append(unmanaged, m)
+		}
+	}
+
+	if err := d.Set("role_name", role.RoleName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("unmanaged_members", unmanaged); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(roleMembersKey(string(region), roleId))
+
+	var diags diag.Diagnostics
+	if len(unmanaged) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Role %s has members not managed by Terraform", role.RoleName.String),
+			Detail:   fmt.Sprintf("The following members were granted outside of this resource: %s. Set exclusive = true to revoke them.", strings.Join(unmanaged, ", ")),
+		})
+	}
+	return diags
+}
+
+// roleMembersApply grants the members in desired that are missing and
+// revokes the members in revoke that are still present.
+func roleMembersApply(ctx context.Context, conn *sqlx.DB, roleName string, current, desired, revoke map[string]bool) error {
+	for _, m := range sortedRoleMembers(desired) {
+		if current[m] {
+			continue
+		}
+		if err := materialize.NewRolePrivilegeBuilder(ctx, conn, roleName, m).Grant(); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range sortedRoleMembers(revoke) {
+		if !current[m] || desired[m] {
+			continue
+		}
+		if err := materialize.NewRolePrivilegeBuilder(ctx, conn, roleName, m).Revoke(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func roleMembersSet(v interface{}) map[string]bool {
+	s := map[string]bool{}
+	for _, m := range v.(*schema.Set).List() {
+		s[m.(string)] = true
+	}
+	return s
+}
+
+func roleMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleId, err := materialize.RoleId(ctx, metaDb, roleName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired := roleMembersSet(d.Get("members"))
+	revoke := map[string]bool{}
+	if d.Get("exclusive").(bool) {
+		revoke = current
+	}
+
+	if err := roleMembersApply(ctx, metaDb, roleName, current, desired, revoke); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(roleMembersKey(string(region), roleId))
+
+	return roleMembersRead(ctx, d, meta)
+}
+
+func roleMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+
+	roleId, err := parseRoleMembersKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, n := d.GetChange("members")
+	desired := roleMembersSet(n)
+
+	// Only members removed from the configuration are revoked, unless the
+	// resource is exclusive.
+	revoke := roleMembersSet(o)
+	if d.Get("exclusive").(bool) {
+		revoke = current
+	}
+
+	if err := roleMembersApply(ctx, metaDb, roleName, current, desired, revoke); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return roleMembersRead(ctx, d, meta)
+}
+
+func roleMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+
+	roleId, err := parseRoleMembersKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	revoke := roleMembersSet(d.Get("members"))
+	if err := roleMembersApply(ctx, metaDb, roleName, current, map[string]bool{}, revoke); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceRoleMembersCreate(t *testing.T) {
+	utils.SetDefaultRegion("aws/us-east-1")
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name": "analyst",
+		"members":   []interface{}{"alice", "bob"},
+	}
+	d := schema.TestResourceDataRaw(t, RoleMembers().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Role Id
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'analyst'`)
+
+		// Current members
+		mp := `WHERE mz_role_members.role_id = 'u1'`
+		testhelpers.MockRoleMembersScan(mock, mp, "alice", "carol")
+
+		// Create
+		mock.ExpectExec(`GRANT "analyst" TO "bob";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice", "bob", "carol")
+
+		diags := roleMembersCreate(context.TODO(), d, db)
+		r.False(diags.HasError())
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+
+		r.Equal("aws/us-east-1:ROLE MEMBERS|u1", d.Id())
+		r.ElementsMatch([]interface{}{"alice", "bob"}, d.Get("members").(*schema.Set).List())
+		r.ElementsMatch([]interface{}{"carol"}, d.Get("unmanaged_members").(*schema.Set).List())
+	})
+}
+
+func TestResourceRoleMembersCreateExclusive(t *testing.T) {
+	utils.SetDefaultRegion("aws/us-east-1")
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name": "analyst",
+		"members":   []interface{}{"alice", "bob"},
+		"exclusive": true,
+	}
+	d := schema.TestResourceDataRaw(t, RoleMembers().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Role Id
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'analyst'`)
+
+		// Current members
+		mp := `WHERE mz_role_members.role_id = 'u1'`
+		testhelpers.MockRoleMembersScan(mock, mp, "alice", "carol")
+
+		// Create
+		mock.ExpectExec(`GRANT "analyst" TO "bob";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE "analyst" FROM "carol";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice", "bob")
+
+		if err := roleMembersCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.ElementsMatch([]interface{}{"alice", "bob"}, d.Get("members").(*schema.Set).List())
+		r.Empty(d.Get("unmanaged_members").(*schema.Set).List())
+	})
+}
+
+func TestResourceRoleMembersReadExclusiveDrift(t *testing.T) {
+	utils.SetDefaultRegion("aws/us-east-1")
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name": "analyst",
+		"members":   []interface{}{"alice"},
+		"exclusive": true,
+	}
+	d := schema.TestResourceDataRaw(t, RoleMembers().Schema, in)
+	d.SetId("aws/us-east-1:ROLE MEMBERS|u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleMembersScan(mock, `WHERE mz_role_members.role_id = 'u1'`, "alice", "mallory")
+
+		if err := roleMembersRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// Out of band members show up as drift to be revoked
+		r.ElementsMatch([]interface{}{"alice", "mallory"}, d.Get("members").(*schema.Set).List())
+	})
+}
+
+func TestResourceRoleMembersDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name": "analyst",
+		"members":   []interface{}{"alice", "bob"},
+	}
+	d := schema.TestResourceDataRaw(t, RoleMembers().Schema, in)
+	d.SetId("aws/us-east-1:ROLE MEMBERS|u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleMembersScan(mock, `WHERE mz_role_members.role_id = 'u1'`, "alice", "carol")
+
+		// Only managed members that are still present are revoked
+		mock.ExpectExec(`REVOKE "analyst" FROM "alice";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := roleMembersDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
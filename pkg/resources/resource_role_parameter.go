@@ -46,7 +46,7 @@ func roleParameterCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewRoleParameterBuilder(metaDb, roleName, variableName, variableValue)
+	b := materialize.NewRoleParameterBuilder(ctx, metaDb, roleName, variableName, variableValue)
 	if err := b.Set(); err != nil {
 		return diag.FromErr(err)
 	}
@@ -80,7 +80,7 @@ func roleParameterDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewRoleParameterBuilder(metaDb, roleName, variableName, "")
+	b := materialize.NewRoleParameterBuilder(ctx, metaDb, roleName, variableName, "")
 	if err := b.Reset(); err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -75,6 +76,7 @@ func TestResourceRoleCreate(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -100,6 +102,7 @@ func TestResourceRoleCreateWithLogin(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -125,6 +128,7 @@ func TestResourceRoleCreateWithPasswordAndLogin(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -150,6 +154,7 @@ func TestResourceRoleCreateWithPasswordNoLogin(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -182,6 +187,7 @@ func TestResourceRoleCreateIfNotExistsAdoptsExisting(t *testing.T) {
 		// Final read by id
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -213,6 +219,7 @@ func TestResourceRoleCreateIfNotExistsCreatesWhenAbsent(t *testing.T) {
 		// Final read by id
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -244,6 +251,7 @@ func TestResourceRoleReadIdMigration(t *testing.T) {
 		// Query Params
 		pp := `WHERE mz_roles.id = 'u1'`
 		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
 
 		if err := roleRead(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -304,3 +312,62 @@ func TestResourceRoleSchema_ExactlyOneOf(t *testing.T) {
 	passwordWoVersionField := Role().Schema["password_wo_version"]
 	require.Contains(t, passwordWoVersionField.RequiredWith, "password_wo")
 }
+
+func TestResourceRoleCreatePasswordUpdatedAt(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":     "role",
+		"login":    true,
+		"password": "password123",
+	}
+	d := schema.TestResourceDataRaw(t, Role().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE ROLE "role" WITH LOGIN PASSWORD 'password123';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_roles.name = 'role'`
+		testhelpers.MockRoleScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_roles.id = 'u1'`
+		testhelpers.MockRoleScan(mock, pp)
+		mock.ExpectQuery(`SELECT updated_at FROM mz_internal.mz_role_auth WHERE role_id = 'u1';`).
+			WillReturnRows(mock.NewRows([]string{"updated_at"}).AddRow(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)))
+
+		if err := roleCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("2025-06-01T12:00:00Z", d.Get("password_updated_at"))
+	})
+}
+
+func TestResourceRoleUpdatePasswordRotationTrigger(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                      "role",
+		"password":                  "password123",
+		"password_rotation_trigger": "2025-06-01T12:00:00Z",
+	}
+	d := schema.TestResourceDataRaw(t, Role().Schema, in)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// The password is set once, for the password change, and not again for the trigger
+		mock.ExpectExec(`ALTER ROLE "role" WITH PASSWORD 'password123';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		pp := `WHERE mz_roles.id = 'u1'`
+		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRolePasswordUpdatedAtScan(mock, `WHERE role_id = 'u1'`)
+
+		if err := roleUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
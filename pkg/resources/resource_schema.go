@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var schemaSchema = map[string]*schema.Schema{
@@ -18,6 +19,8 @@ var schemaSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("schema"),
 	"comment":            CommentSchema(false),
 	"ownership_role":     OwnershipRoleSchema(),
+	"execute_as_role":    ExecuteAsRoleSchema(),
+	"session_options":    SessionOptionsSchema(),
 	"identify_by_name": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -55,7 +58,7 @@ func schemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	value := utils.ExtractId(fullId)
 	useNameAsId := d.Get("identify_by_name").(bool)
 
-	s, err := materialize.ScanSchema(metaDb, value, idType == "name")
+	s, err := materialize.ScanSchema(ctx, metaDb, value, idType == "name")
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -101,20 +104,13 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Schema, Name: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSchemaBuilder(metaDb, o)
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSchemaBuilder(ctx, db, o)
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
@@ -123,7 +119,7 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if identifyByName {
 		d.SetId(utils.TransformIdWithTypeAndRegion(string(region), "name", databaseName+"|"+schemaName))
 	} else {
-		schemaId, err := materialize.SchemaId(metaDb, o)
+		schemaId, err := materialize.SchemaId(ctx, metaDb, o)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -142,7 +138,7 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Schema, Name: schemaName, DatabaseName: databaseName}
-	b := materialize.NewOwnershipBuilder(metaDb, o)
+	b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 	if d.HasChange("identify_by_name") {
 		_, newIdentifyByName := d.GetChange("identify_by_name")
@@ -155,7 +151,7 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		if identifyByName {
 			newId = utils.TransformIdWithTypeAndRegion(string(region), "name", databaseName+"|"+schemaName)
 		} else {
-			schemaId, err := materialize.SchemaId(metaDb, o)
+			schemaId, err := materialize.SchemaId(ctx, metaDb, o)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -176,7 +172,7 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -186,7 +182,7 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Schema, Name: oldName.(string), DatabaseName: databaseName}
-		b := materialize.NewSchemaBuilder(metaDb, o)
+		b := materialize.NewSchemaBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -212,7 +208,7 @@ func schemaImport(ctx context.Context, d *schema.ResourceData, meta interface{})
 	value := utils.ExtractId(fullId)
 	identifyByName := idType == "name"
 
-	s, err := materialize.ScanSchema(metaDb, value, identifyByName)
+	s, err := materialize.ScanSchema(ctx, metaDb, value, identifyByName)
 	if err != nil {
 		return nil, fmt.Errorf("error importing schema %s: %w", fullId, err)
 	}
@@ -242,7 +238,7 @@ func schemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSchemaBuilder(metaDb, o)
+	b := materialize.NewSchemaBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
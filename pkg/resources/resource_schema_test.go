@@ -162,6 +162,32 @@ func TestResourceSchemaReadIdentifyByName(t *testing.T) {
 	})
 }
 
+// Confirm read accepts the quoted region:name:"database"."schema" form used by
+// schema-scoped resources
+func TestResourceSchemaReadIdentifyByNameQuoted(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "schema",
+		"database_name":    "database",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, Schema().Schema, in)
+	r.NotNil(d)
+	d.SetId(`aws/us-east-1:name:"database"."schema"`)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		pp := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockSchemaScan(mock, pp)
+
+		if err := schemaRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:name:database|schema", d.Id())
+	})
+}
+
 func TestResourceSchemaDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -0,0 +1,322 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/frontegg"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var scimGroupRoleSyncSchema = map[string]*schema.Schema{
+	"group_id": {
+		Description: "The ID of the SCIM group whose users are synchronized.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"role_name": {
+		Description: "The SQL role the users of the group are granted.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"exclusive": {
+		Description: "If `true`, members of `role_name` that are not users of the group are revoked. If `false`, they are left in place and reported in `unmanaged_members`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"members": {
+		Description: "The SQL roles synced from the group that are members of `role_name`. Users that left the group are listed until they are revoked on the next apply.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	},
+	"unmanaged_members": {
+		Description: "Members of `role_name` that are not users of the group. Always empty when `exclusive` is `true`.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	},
+	"pending_users": {
+		Description: "Users of the group that do not have a SQL role yet. Materialize creates the role of a user the first time they log in, they are granted `role_name` on the next apply after that.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	},
+	"region": RegionSchema(),
+}
+
+func SCIM2GroupRoleSync() *schema.Resource {
+	return &schema.Resource{
+		Description: "Keeps the members of a SQL role in sync with the users of a SCIM group, so that the identity provider is the single source of truth for data access. " +
+			"Changes to the group are detected on every plan and applied with `GRANT` and `REVOKE`.",
+
+		CreateContext: scimGroupRoleSyncCreate,
+		ReadContext:   scimGroupRoleSyncRead,
+		UpdateContext: scimGroupRoleSyncUpdate,
+		DeleteContext: scimGroupRoleSyncDelete,
+
+		CustomizeDiff: scimGroupRoleSyncCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: scimGroupRoleSyncSchema,
+	}
+}
+
+func scimGroupRoleSyncKey(region, groupId, roleId string) string {
+	return fmt.Sprintf(`%s:SCIM GROUP ROLE|%s|%s`, region, groupId, roleId)
+}
+
+func parseScimGroupRoleSyncKey(id string) (string, string, error) {
+	ie := strings.Split(utils.ExtractId(id), "|")
+
+	if len(ie) != 3 || ie[0] != "SCIM GROUP ROLE" {
+		return "", "", fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return ie[1], ie[2], nil
+}
+
+// scimGroupRoles returns the SQL roles of the users in the group, which in
+// Materialize Cloud are named after the email of the user, and the users that
+// do not have a role yet.
+func scimGroupRoles(ctx context.Context, client *clients.FronteggClient, conn *sqlx.DB, groupId string) (map[string]bool, []string, error) {
+	group, err := frontegg.GetSCIMGroupByID(ctx, client, groupId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching SCIM group: %s", err)
+	}
+
+	roles := map[string]bool{}
+	var pending []string
+	for _, u := range group.Users {
+		if u.Email == "" {
+			continue
+		}
+		if _, err := materialize.RoleId(ctx, conn, u.Email); err == sql.ErrNoRows {
+			pending = append(pending, u.Email)
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		roles[u.Email] = true
+	}
+	return roles, pending, nil
+}
+
+func scimGroupRoleSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupId, roleId, err := parseScimGroupRoleSyncKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, err := materialize.ScanRole(ctx, metaDb, roleId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupRoles, pending, err := scimGroupRoles(ctx, providerMeta.Frontegg, metaDb, groupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Members synced before stay in members after they leave the group, so
+	// that the next plan shows them leaving and the apply revokes them.
+	synced := roleMembersSet(d.Get("members"))
+	members := []string{}
+	unmanaged := []string{}
+	for _, m := range sortedRoleMembers(current) {
+		if groupRoles[m] || synced[m] {
+			members = append(members, m)
+		} else {
+			unmanaged = append(unmanaged, m)
+		}
+	}
+
+	if err := d.Set("group_id", groupId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("role_name", role.RoleName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("unmanaged_members", unmanaged); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("pending_users", pending); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(scimGroupRoleSyncKey(string(region), groupId, roleId))
+
+	var diags diag.Diagnostics
+	if len(unmanaged) > 0 && !d.Get("exclusive").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Role %s has members that are not users of SCIM group %s", role.RoleName.String, groupId),
+			Detail:   fmt.Sprintf("The following members were granted outside of the group: %s. Set exclusive = true to revoke them.", strings.Join(unmanaged, ", ")),
+		})
+	}
+	return diags
+}
+
+// scimGroupRoleSyncCustomizeDiff plans the members from the users currently in
+// the group, so that changes made in the identity provider show up as a diff.
+func scimGroupRoleSyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("group_id") {
+		return nil
+	}
+
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return err
+	}
+
+	dbClient, ok := providerMeta.DB[clients.Region(d.Get("region").(string))]
+	if !ok {
+		return nil
+	}
+
+	groupRoles, _, err := scimGroupRoles(ctx, providerMeta.Frontegg, dbClient.SQLX(), d.Get("group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	members := roleMembersSet(d.Get("members"))
+	inSync := len(members) == len(groupRoles)
+	for m := range groupRoles {
+		inSync = inSync && members[m]
+	}
+	if !inSync {
+		if err := d.SetNew("members", sortedRoleMembers(groupRoles)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("exclusive").(bool) && d.Get("unmanaged_members").(*schema.Set).Len() > 0 {
+		if err := d.SetNew("unmanaged_members", []string{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scimGroupRoleSyncApply(ctx context.Context, d *schema.ResourceData, meta interface{}, previous map[string]bool) diag.Diagnostics {
+	groupId := d.Get("group_id").(string)
+	roleName := d.Get("role_name").(string)
+
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := providerMeta.ValidateSaaSOnly("materialize_scim_group_role_sync"); diags.HasError() {
+		return diags
+	}
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleId, err := materialize.RoleId(ctx, metaDb, roleName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupRoles, _, err := scimGroupRoles(ctx, providerMeta.Frontegg, metaDb, groupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Members synced from the group before are revoked once they leave it,
+	// other members only when the resource is exclusive.
+	revoke := previous
+	if d.Get("exclusive").(bool) {
+		revoke = current
+	}
+
+	if err := roleMembersApply(ctx, metaDb, roleName, current, groupRoles, revoke); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(scimGroupRoleSyncKey(string(region), groupId, roleId))
+
+	return scimGroupRoleSyncRead(ctx, d, meta)
+}
+
+func scimGroupRoleSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return scimGroupRoleSyncApply(ctx, d, meta, map[string]bool{})
+}
+
+func scimGroupRoleSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, _ := d.GetChange("members")
+	return scimGroupRoleSyncApply(ctx, d, meta, roleMembersSet(o))
+}
+
+func scimGroupRoleSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+
+	_, roleId, err := parseScimGroupRoleSyncKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := currentRoleMembers(ctx, metaDb, roleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	revoke := roleMembersSet(d.Get("members"))
+	if err := roleMembersApply(ctx, metaDb, roleName, current, map[string]bool{}, revoke); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/frontegg"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+// withMockScimGroup serves a SCIM group with the given user emails.
+func withMockScimGroup(t *testing.T, db *utils.ProviderMeta, emails ...string) {
+	group := frontegg.ScimGroup{ID: "group-1", Name: "Analysts"}
+	for _, e := range emails {
+		group.Users = append(group.Users, frontegg.ScimUser{ID: e, Email: e})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/frontegg/identity/resources/groups/v1/group-1/", req.URL.Path)
+		json.NewEncoder(w).Encode(group)
+	}))
+	t.Cleanup(server.Close)
+
+	db.Frontegg.Endpoint = server.URL
+	db.Frontegg.HTTPClient = &http.Client{}
+}
+
+func TestResourceScimGroupRoleSyncCreate(t *testing.T) {
+	utils.SetDefaultRegion("aws/us-east-1")
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"group_id":  "group-1",
+		"role_name": "analyst",
+	}
+	d := schema.TestResourceDataRaw(t, SCIM2GroupRoleSync().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		withMockScimGroup(t, db, "alice@example.com", "bob@example.com")
+
+		// Role Id
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'analyst'`)
+
+		// Group users, bob has not logged in yet
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleScanNoRows(mock, `WHERE mz_roles.name = 'bob@example.com'`)
+
+		// Current members
+		mp := `WHERE mz_role_members.role_id = 'u1'`
+		testhelpers.MockRoleMembersScan(mock, mp, "carol@example.com")
+
+		// Create
+		mock.ExpectExec(`GRANT "analyst" TO "alice@example.com";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleScanNoRows(mock, `WHERE mz_roles.name = 'bob@example.com'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice@example.com", "carol@example.com")
+
+		diags := scimGroupRoleSyncCreate(context.TODO(), d, db)
+		r.False(diags.HasError())
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+
+		r.Equal("aws/us-east-1:SCIM GROUP ROLE|group-1|u1", d.Id())
+		r.ElementsMatch([]interface{}{"alice@example.com"}, d.Get("members").(*schema.Set).List())
+		r.ElementsMatch([]interface{}{"carol@example.com"}, d.Get("unmanaged_members").(*schema.Set).List())
+		r.ElementsMatch([]interface{}{"bob@example.com"}, d.Get("pending_users").(*schema.Set).List())
+	})
+}
+
+func TestResourceScimGroupRoleSyncCreateExclusive(t *testing.T) {
+	utils.SetDefaultRegion("aws/us-east-1")
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"group_id":  "group-1",
+		"role_name": "analyst",
+		"exclusive": true,
+	}
+	d := schema.TestResourceDataRaw(t, SCIM2GroupRoleSync().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		withMockScimGroup(t, db, "alice@example.com")
+
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'analyst'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+
+		mp := `WHERE mz_role_members.role_id = 'u1'`
+		testhelpers.MockRoleMembersScan(mock, mp, "carol@example.com")
+
+		mock.ExpectExec(`GRANT "analyst" TO "alice@example.com";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`REVOKE "analyst" FROM "carol@example.com";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice@example.com")
+
+		diags := scimGroupRoleSyncCreate(context.TODO(), d, db)
+		r.False(diags.HasError())
+		r.Empty(diags)
+		r.Empty(d.Get("unmanaged_members").(*schema.Set).List())
+	})
+}
+
+func TestResourceScimGroupRoleSyncDelete(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"group_id":  "group-1",
+		"role_name": "analyst",
+		"members":   []interface{}{"alice@example.com"},
+	}
+	d := schema.TestResourceDataRaw(t, SCIM2GroupRoleSync().Schema, in)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:SCIM GROUP ROLE|group-1|u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mp := `WHERE mz_role_members.role_id = 'u1'`
+		testhelpers.MockRoleMembersScan(mock, mp, "alice@example.com", "carol@example.com")
+
+		// Members granted outside of the group are kept
+		mock.ExpectExec(`REVOKE "analyst" FROM "alice@example.com";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := scimGroupRoleSyncDelete(context.TODO(), d, db)
+		r.False(diags.HasError())
+	})
+}
+
+// scimGroupRoleSyncState is the state of a non-exclusive sync of the group to
+// joe, the role returned by MockRoleScan, that synced alice and bob.
+func scimGroupRoleSyncState(t *testing.T) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, SCIM2GroupRoleSync().Schema, map[string]interface{}{
+		"group_id":  "group-1",
+		"role_name": "joe",
+		"region":    "aws/us-east-1",
+	})
+	d.SetId("aws/us-east-1:SCIM GROUP ROLE|group-1|u1")
+	require.NoError(t, d.Set("members", []string{"alice@example.com", "bob@example.com"}))
+	return d
+}
+
+func TestResourceScimGroupRoleSyncReadUserLeftGroup(t *testing.T) {
+	r := require.New(t)
+	d := scimGroupRoleSyncState(t)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// bob left the group
+		withMockScimGroup(t, db, "alice@example.com")
+
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleMembersScan(mock, `WHERE mz_role_members.role_id = 'u1'`, "alice@example.com", "bob@example.com")
+
+		diags := scimGroupRoleSyncRead(context.TODO(), d, db)
+		r.Empty(diags)
+
+		// bob is still a synced member, not an unmanaged one
+		r.ElementsMatch([]interface{}{"alice@example.com", "bob@example.com"}, d.Get("members").(*schema.Set).List())
+		r.Empty(d.Get("unmanaged_members").(*schema.Set).List())
+	})
+}
+
+func TestResourceScimGroupRoleSyncUpdateUserLeftGroup(t *testing.T) {
+	r := require.New(t)
+	res := SCIM2GroupRoleSync()
+	state := scimGroupRoleSyncState(t).State()
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// bob left the group
+		withMockScimGroup(t, db, "alice@example.com")
+
+		// Refresh
+		mp := `WHERE mz_role_members.role_id = 'u1'`
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice@example.com", "bob@example.com")
+
+		// Plan
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+
+		// Apply
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'joe'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice@example.com", "bob@example.com")
+		mock.ExpectExec(`REVOKE "joe" FROM "bob@example.com";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'alice@example.com'`)
+		testhelpers.MockRoleMembersScan(mock, mp, "alice@example.com")
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"group_id":  "group-1",
+			"role_name": "joe",
+			"region":    "aws/us-east-1",
+		})
+		state, diags := res.RefreshWithoutUpgrade(context.TODO(), state, db)
+		r.False(diags.HasError(), "%v", diags)
+		diff, err := res.Diff(context.TODO(), state, config, db)
+		r.NoError(err)
+		r.NotNil(diff)
+		r.False(diff.RequiresNew())
+		removed := []string{}
+		for _, a := range diff.Attributes {
+			if a.NewRemoved {
+				removed = append(removed, a.Old)
+			}
+		}
+		r.Equal([]string{"bob@example.com"}, removed)
+
+		newState, diags := res.Apply(context.TODO(), state, diff, db)
+		r.False(diags.HasError(), "%v", diags)
+		r.NoError(mock.ExpectationsWereMet())
+		r.Equal("1", newState.Attributes["members.#"])
+		r.Equal("0", newState.Attributes["unmanaged_members.#"])
+	})
+}
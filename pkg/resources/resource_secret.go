@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var secretSchema = map[string]*schema.Schema{
@@ -40,8 +42,11 @@ var secretSchema = map[string]*schema.Schema{
 		Optional:     true,
 		RequiredWith: []string{"value_wo"},
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("secret"),
+	"region":           RegionSchema(),
 }
 
 func Secret() *schema.Resource {
@@ -50,11 +55,11 @@ func Secret() *schema.Resource {
 
 		CreateContext: secretCreate,
 		ReadContext:   secretRead,
-		UpdateContext: secretUpdate,
+		UpdateContext: updateByCatalogId(materialize.Secret, materialize.SecretId, secretUpdate),
 		DeleteContext: secretDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.Secret, materialize.SecretId),
 		},
 
 		Schema: secretSchema,
@@ -62,13 +67,12 @@ func Secret() *schema.Resource {
 }
 
 func secretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSecret(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Secret, materialize.SecretId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -76,7 +80,15 @@ func secretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanSecret(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SecretName.String))
 
 	if err := d.Set("name", s.SecretName.String); err != nil {
 		return diag.FromErr(err)
@@ -116,34 +128,27 @@ func secretCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Secret, Name: secretName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSecretBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("value"); ok {
-		b.Value(v.(string))
-	} else if valueWo, _ := d.GetRawConfigAt(cty.GetAttrPath("value_wo")); !valueWo.IsNull() {
-		if !valueWo.Type().Equals(cty.String) {
-			return diag.Errorf("error retrieving write-only argument: value_wo - retrieved config value is not a string")
-		}
-		b.Value(valueWo.AsString())
-	}
-
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSecretBuilder(ctx, db, o)
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("value"); ok {
+			b.Value(v.(string))
+		} else if valueWo, _ := d.GetRawConfigAt(cty.GetAttrPath("value_wo")); !valueWo.IsNull() {
+			if !valueWo.Type().Equals(cty.String) {
+				return nil, errors.New("error retrieving write-only argument: value_wo - retrieved config value is not a string")
+			}
+			b.Value(valueWo.AsString())
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SecretId(metaDb, o)
+	i, err := materialize.SecretId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,12 +167,12 @@ func secretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Secret, Name: secretName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSecretBuilder(metaDb, o)
+	b := materialize.NewSecretBuilder(ctx, metaDb, o)
 
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Secret, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSecretBuilder(metaDb, o)
+		b := materialize.NewSecretBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -193,7 +198,7 @@ func secretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -202,7 +207,7 @@ func secretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -222,7 +227,7 @@ func secretDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: secretName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSecretBuilder(metaDb, o)
+	b := materialize.NewSecretBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
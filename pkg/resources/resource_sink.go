@@ -12,13 +12,20 @@ import (
 )
 
 func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSink(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseSink, materialize.SinkId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanSink(ctx, metaDb, i)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -26,7 +33,7 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SinkName.String))
 
 	if err := d.Set("name", s.SinkName.String); err != nil {
 		return diag.FromErr(err)
@@ -78,7 +85,7 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseSink, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSink(metaDb, o)
+		b := materialize.NewSink(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -87,7 +94,7 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if d.HasChange("from") {
 		oldFrom, newFrom := d.GetChange("from")
 		from := materialize.GetIdentifierSchemaStruct(newFrom)
-		b := materialize.NewSink(metaDb, o)
+		b := materialize.NewSink(ctx, metaDb, o)
 		if err := b.AlterFrom(from); err != nil {
 			d.Set("from", oldFrom)
 			return diag.FromErr(err)
@@ -96,7 +103,7 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -105,7 +112,7 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -125,7 +132,7 @@ func sinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: sinkName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSink(metaDb, o)
+	b := materialize.NewSink(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
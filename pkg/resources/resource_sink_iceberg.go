@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var sinkIcebergSchema = map[string]*schema.Schema{
@@ -68,8 +69,11 @@ var sinkIcebergSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("sink"),
+	"region":           RegionSchema(),
 }
 
 func SinkIceberg() *schema.Resource {
@@ -78,11 +82,11 @@ func SinkIceberg() *schema.Resource {
 
 		CreateContext: sinkIcebergCreate,
 		ReadContext:   sinkRead,
-		UpdateContext: sinkUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSink, materialize.SinkId, sinkUpdate),
 		DeleteContext: sinkDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSink, materialize.SinkId),
 		},
 
 		Schema: sinkIcebergSchema,
@@ -99,68 +103,61 @@ func sinkIcebergCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSink, Name: sinkName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSinkIcebergBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
-
-	if v, ok := d.GetOk("from"); ok {
-		from := materialize.GetIdentifierSchemaStruct(v)
-		b.From(from)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSinkIcebergBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("iceberg_catalog_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.IcebergCatalogConnection(conn)
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("namespace"); ok {
-		b.Namespace(v.(string))
-	}
+		if v, ok := d.GetOk("from"); ok {
+			from := materialize.GetIdentifierSchemaStruct(v)
+			b.From(from)
+		}
 
-	if v, ok := d.GetOk("table"); ok {
-		b.Table(v.(string))
-	}
+		if v, ok := d.GetOk("iceberg_catalog_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.IcebergCatalogConnection(conn)
+		}
 
-	if v, ok := d.GetOk("aws_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.AwsConnection(conn)
-	}
+		if v, ok := d.GetOk("namespace"); ok {
+			b.Namespace(v.(string))
+		}
 
-	if v, ok := d.GetOk("key"); ok && len(v.([]interface{})) > 0 {
-		keys, err := materialize.GetSliceValueString("key", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("table"); ok {
+			b.Table(v.(string))
 		}
-		b.Key(keys)
-	}
 
-	if v, ok := d.GetOk("key_not_enforced"); ok {
-		b.KeyNotEnforced(v.(bool))
-	}
+		if v, ok := d.GetOk("aws_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.AwsConnection(conn)
+		}
 
-	if v, ok := d.GetOk("commit_interval"); ok {
-		b.CommitInterval(v.(string))
-	}
+		if v, ok := d.GetOk("key"); ok && len(v.([]interface{})) > 0 {
+			keys, err := materialize.GetSliceValueString("key", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.Key(keys)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("key_not_enforced"); ok {
+			b.KeyNotEnforced(v.(bool))
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("commit_interval"); ok {
+			b.CommitInterval(v.(string))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SinkId(metaDb, o)
+	i, err := materialize.SinkId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var sinkKafkaSchema = map[string]*schema.Schema{
@@ -106,7 +107,9 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Default:     true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
+	"ownership_role":  OwnershipRoleSchema(),
+	"execute_as_role": ExecuteAsRoleSchema(),
+	"session_options": SessionOptionsSchema(),
 	"key_not_enforced": {
 		Description: "Disable Materialize's validation of the key's uniqueness.",
 		Type:        schema.TypeBool,
@@ -126,7 +129,8 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"region": RegionSchema(),
+	"identify_by_name": IdentifyByNameSchema("sink"),
+	"region":           RegionSchema(),
 }
 
 func SinkKafka() *schema.Resource {
@@ -135,11 +139,11 @@ func SinkKafka() *schema.Resource {
 
 		CreateContext: sinkKafkaCreate,
 		ReadContext:   sinkRead,
-		UpdateContext: sinkUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSink, materialize.SinkId, sinkUpdate),
 		DeleteContext: sinkDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSink, materialize.SinkId),
 		},
 
 		Schema: sinkKafkaSchema,
@@ -156,95 +160,88 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSink, Name: sinkName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSinkKafkaBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
-
-	if v, ok := d.GetOk("from"); ok {
-		from := materialize.GetIdentifierSchemaStruct(v)
-		b.From(from)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSinkKafkaBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("kafka_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.KafkaConnection(conn)
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("topic"); ok {
-		b.Topic(v.(string))
-	}
+		if v, ok := d.GetOk("from"); ok {
+			from := materialize.GetIdentifierSchemaStruct(v)
+			b.From(from)
+		}
 
-	if v, ok := d.GetOk("topic_replication_factor"); ok {
-		b.TopicReplicationFactor(v.(int))
-	}
+		if v, ok := d.GetOk("kafka_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.KafkaConnection(conn)
+		}
 
-	if v, ok := d.GetOk("topic_partition_count"); ok {
-		b.TopicPartitionCount(v.(int))
-	}
+		if v, ok := d.GetOk("topic"); ok {
+			b.Topic(v.(string))
+		}
 
-	if v, ok := d.GetOk("topic_config"); ok {
-		config := make(map[string]string)
-		for k, v := range v.(map[string]interface{}) {
-			config[k] = v.(string)
+		if v, ok := d.GetOk("topic_replication_factor"); ok {
+			b.TopicReplicationFactor(v.(int))
 		}
-		b.TopicConfig(config)
-	}
 
-	if v, ok := d.GetOk("compression_type"); ok {
-		b.CompressionType(v.(string))
-	}
+		if v, ok := d.GetOk("topic_partition_count"); ok {
+			b.TopicPartitionCount(v.(int))
+		}
 
-	if v, ok := d.GetOk("key"); ok && len(v.([]interface{})) > 0 {
-		keys, err := materialize.GetSliceValueString("key", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("topic_config"); ok {
+			config := make(map[string]string)
+			for k, v := range v.(map[string]interface{}) {
+				config[k] = v.(string)
+			}
+			b.TopicConfig(config)
 		}
-		b.Key(keys)
-	}
 
-	// Both booleans carry a schema default, so read them with Get: GetOk cannot
-	// tell a configured false from an unset value.
-	b.KeyNotEnforced(d.Get("key_not_enforced").(bool))
+		if v, ok := d.GetOk("compression_type"); ok {
+			b.CompressionType(v.(string))
+		}
 
-	if v, ok := d.GetOk("format"); ok {
-		format := materialize.GetSinkFormatSpecStruc(v)
-		b.Format(format)
-	}
+		if v, ok := d.GetOk("key"); ok && len(v.([]interface{})) > 0 {
+			keys, err := materialize.GetSliceValueString("key", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.Key(keys)
+		}
 
-	if v, ok := d.GetOk("envelope"); ok {
-		envelope := materialize.GetSinkKafkaEnelopeStruct(v)
-		b.Envelope(envelope)
-	}
+		// Both booleans carry a schema default, so read them with Get: GetOk cannot
+		// tell a configured false from an unset value.
+		b.KeyNotEnforced(d.Get("key_not_enforced").(bool))
 
-	b.Snapshot(d.Get("snapshot").(bool))
+		if v, ok := d.GetOk("format"); ok {
+			format := materialize.GetSinkFormatSpecStruc(v)
+			b.Format(format)
+		}
 
-	if v, ok := d.GetOk("headers"); ok {
-		b.Headers(v.(string))
-	}
+		if v, ok := d.GetOk("envelope"); ok {
+			envelope := materialize.GetSinkKafkaEnelopeStruct(v)
+			b.Envelope(envelope)
+		}
 
-	if v, ok := d.GetOk("partition_by"); ok {
-		b.PartitionBy(v.(string))
-	}
+		b.Snapshot(d.Get("snapshot").(bool))
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("headers"); ok {
+			b.Headers(v.(string))
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("partition_by"); ok {
+			b.PartitionBy(v.(string))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SinkId(metaDb, o)
+	i, err := materialize.SinkId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -12,13 +12,20 @@ import (
 )
 
 func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSource(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseSource, materialize.SourceId)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanSource(ctx, metaDb, i)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -26,7 +33,7 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SourceName.String))
 
 	if err := d.Set("name", s.SourceName.String); err != nil {
 		return diag.FromErr(err)
@@ -47,7 +54,7 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	// perpetually diffing against the pre-resize value.
 	size := s.Size.String
 	if s.ClusterId.Valid {
-		pending, inFlight, err := materialize.ScanClusterPendingReconfiguration(metaDb, s.ClusterId.String)
+		pending, inFlight, err := materialize.ScanClusterPendingReconfiguration(ctx, metaDb, s.ClusterId.String)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -125,7 +132,7 @@ func sourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSource(metaDb, o)
+		b := materialize.NewSource(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -133,7 +140,7 @@ func sourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -142,7 +149,7 @@ func sourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -162,7 +169,7 @@ func sourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
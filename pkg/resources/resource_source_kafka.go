@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourceKafkaSchema = map[string]*schema.Schema{
@@ -214,8 +215,11 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("source"),
+	"region":           RegionSchema(),
 }
 
 func SourceKafka() *schema.Resource {
@@ -224,11 +228,11 @@ func SourceKafka() *schema.Resource {
 
 		CreateContext: sourceKafkaCreate,
 		ReadContext:   sourceKafkaRead,
-		UpdateContext: sourceUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSource, materialize.SourceId, sourceUpdate),
 		DeleteContext: sourceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSource, materialize.SourceId),
 		},
 
 		Schema: sourceKafkaSchema,
@@ -245,112 +249,105 @@ func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceKafkaBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
-
-	if v, ok := d.GetOk("kafka_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.KafkaConnection(conn)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceKafkaBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("topic"); ok {
-		b.Topic(v.(string))
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("include_key"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_key_alias"); ok {
-			b.IncludeKeyAlias(alias.(string))
-		} else {
-			b.IncludeKey()
+		if v, ok := d.GetOk("kafka_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.KafkaConnection(conn)
 		}
-	}
 
-	if v, ok := d.GetOk("include_partition"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_partition_alias"); ok {
-			b.IncludePartitionAlias(alias.(string))
-		} else {
-			b.IncludePartition()
+		if v, ok := d.GetOk("topic"); ok {
+			b.Topic(v.(string))
 		}
-	}
 
-	if v, ok := d.GetOk("include_offset"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_offset_alias"); ok {
-			b.IncludeOffsetAlias(alias.(string))
-		} else {
-			b.IncludeOffset()
+		if v, ok := d.GetOk("include_key"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_key_alias"); ok {
+				b.IncludeKeyAlias(alias.(string))
+			} else {
+				b.IncludeKey()
+			}
 		}
-	}
 
-	if v, ok := d.GetOk("include_timestamp"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_timestamp_alias"); ok {
-			b.IncludeTimestampAlias(alias.(string))
-		} else {
-			b.IncludeTimestamp()
+		if v, ok := d.GetOk("include_partition"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_partition_alias"); ok {
+				b.IncludePartitionAlias(alias.(string))
+			} else {
+				b.IncludePartition()
+			}
 		}
-	}
 
-	if v, ok := d.GetOk("include_headers"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_headers_alias"); ok {
-			b.IncludeHeadersAlias(alias.(string))
-		} else {
-			b.IncludeHeaders()
+		if v, ok := d.GetOk("include_offset"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_offset_alias"); ok {
+				b.IncludeOffsetAlias(alias.(string))
+			} else {
+				b.IncludeOffset()
+			}
 		}
-	}
 
-	if v, ok := d.GetOk("format"); ok {
-		format := materialize.GetFormatSpecStruc(v)
-		b.Format(format)
-	}
+		if v, ok := d.GetOk("include_timestamp"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_timestamp_alias"); ok {
+				b.IncludeTimestampAlias(alias.(string))
+			} else {
+				b.IncludeTimestamp()
+			}
+		}
 
-	if v, ok := d.GetOk("key_format"); ok {
-		format := materialize.GetFormatSpecStruc(v)
-		b.KeyFormat(format)
-	}
+		if v, ok := d.GetOk("include_headers"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_headers_alias"); ok {
+				b.IncludeHeadersAlias(alias.(string))
+			} else {
+				b.IncludeHeaders()
+			}
+		}
 
-	if v, ok := d.GetOk("value_format"); ok {
-		format := materialize.GetFormatSpecStruc(v)
-		b.ValueFormat(format)
-	}
+		if v, ok := d.GetOk("format"); ok {
+			format := materialize.GetFormatSpecStruc(v)
+			b.Format(format)
+		}
 
-	if v, ok := d.GetOk("envelope"); ok {
-		envelope := materialize.GetSourceKafkaEnvelopeStruct(v)
-		b.Envelope(envelope)
-	}
+		if v, ok := d.GetOk("key_format"); ok {
+			format := materialize.GetFormatSpecStruc(v)
+			b.KeyFormat(format)
+		}
 
-	if v, ok := d.GetOk("start_offset"); ok {
-		so := materialize.GetSliceValueInt(v.([]interface{}))
-		b.StartOffset(so)
-	}
+		if v, ok := d.GetOk("value_format"); ok {
+			format := materialize.GetFormatSpecStruc(v)
+			b.ValueFormat(format)
+		}
 
-	if v, ok := d.GetOk("start_timestamp"); ok {
-		b.StartTimestamp(v.(int))
-	}
+		if v, ok := d.GetOk("envelope"); ok {
+			envelope := materialize.GetSourceKafkaEnvelopeStruct(v)
+			b.Envelope(envelope)
+		}
 
-	if v, ok := d.GetOk("expose_progress"); ok {
-		e := materialize.GetIdentifierSchemaStruct(v)
-		b.ExposeProgress(e)
-	}
+		if v, ok := d.GetOk("start_offset"); ok {
+			so := materialize.GetSliceValueInt(v.([]interface{}))
+			b.StartOffset(so)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("start_timestamp"); ok {
+			b.StartTimestamp(v.(int))
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("expose_progress"); ok {
+			e := materialize.GetIdentifierSchemaStruct(v)
+			b.ExposeProgress(e)
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SourceId(metaDb, o)
+	i, err := materialize.SourceId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -360,13 +357,12 @@ func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 }
 
 func sourceKafkaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSource(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseSource, materialize.SourceId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -374,7 +370,15 @@ func sourceKafkaRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanSource(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SourceName.String))
 
 	if err := d.Set("name", s.SourceName.String); err != nil {
 		return diag.FromErr(err)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var tick_interval = &schema.Schema{
@@ -103,8 +104,11 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("source"),
+	"region":           RegionSchema(),
 }
 
 func SourceLoadgen() *schema.Resource {
@@ -113,11 +117,11 @@ func SourceLoadgen() *schema.Resource {
 
 		CreateContext: sourceLoadgenCreate,
 		ReadContext:   sourceRead,
-		UpdateContext: sourceUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSource, materialize.SourceId, sourceUpdate),
 		DeleteContext: sourceLoadgenDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSource, materialize.SourceId),
 		},
 
 		Schema: sourceLoadgenSchema,
@@ -134,53 +138,46 @@ func sourceLoadgenCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceLoadgenBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceLoadgenBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("expose_progress"); ok {
-		e := materialize.GetIdentifierSchemaStruct(v)
-		b.ExposeProgress(e)
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("load_generator_type"); ok {
-		b.LoadGeneratorType(v.(string))
-	}
+		if v, ok := d.GetOk("expose_progress"); ok {
+			e := materialize.GetIdentifierSchemaStruct(v)
+			b.ExposeProgress(e)
+		}
 
-	if v, ok := d.GetOk("auction_options"); ok {
-		o := materialize.GetAuctionOptionsStruct(v)
-		b.AuctionOptions(o)
-	}
+		if v, ok := d.GetOk("load_generator_type"); ok {
+			b.LoadGeneratorType(v.(string))
+		}
 
-	if v, ok := d.GetOk("marketing_options"); ok {
-		o := materialize.GetMarketingOptionsStruct(v)
-		b.MarketingOptions(o)
-	}
+		if v, ok := d.GetOk("auction_options"); ok {
+			o := materialize.GetAuctionOptionsStruct(v)
+			b.AuctionOptions(o)
+		}
 
-	if v, ok := d.GetOk("tpch_options"); ok {
-		o := materialize.GetTPCHOptionsStruct(v)
-		b.TPCHOptions(o)
-	}
+		if v, ok := d.GetOk("marketing_options"); ok {
+			o := materialize.GetMarketingOptionsStruct(v)
+			b.MarketingOptions(o)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("tpch_options"); ok {
+			o := materialize.GetTPCHOptionsStruct(v)
+			b.TPCHOptions(o)
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SourceId(metaDb, o)
+	i, err := materialize.SourceId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -199,7 +196,7 @@ func sourceLoadgenDelete(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if err := b.DropCascade(); err != nil {
 		return diag.FromErr(err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourceMySQLSchema = map[string]*schema.Schema{
@@ -90,8 +91,11 @@ var sourceMySQLSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("source"),
+	"region":           RegionSchema(),
 }
 
 func SourceMySQL() *schema.Resource {
@@ -100,11 +104,11 @@ func SourceMySQL() *schema.Resource {
 
 		CreateContext: sourceMySQLCreate,
 		ReadContext:   sourceMySQLRead,
-		UpdateContext: sourceMySQLUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSource, materialize.SourceId, sourceMySQLUpdate),
 		DeleteContext: sourceMySQLDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSource, materialize.SourceId),
 		},
 
 		Schema: sourceMySQLSchema,
@@ -122,63 +126,57 @@ func sourceMySQLCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceMySQLBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
-
-	if v, ok := d.GetOk("mysql_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.MySQLConnection(conn)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceMySQLBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("table"); ok {
-		tables := v.(*schema.Set).List()
-		t := materialize.GetTableStruct(tables)
-		b.Tables(t)
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("all_tables"); ok && v.(bool) {
-		b.AllTables()
-	}
+		if v, ok := d.GetOk("mysql_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.MySQLConnection(conn)
+		}
 
-	if v, ok := d.GetOk("ignore_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("ignore_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("table"); ok {
+			tables := v.(*schema.Set).List()
+			t := materialize.GetTableStruct(tables)
+			b.Tables(t)
 		}
-		b.IgnoreColumns(columns)
-	}
 
-	if v, ok := d.GetOk("text_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("all_tables"); ok && v.(bool) {
+			b.AllTables()
 		}
-		b.TextColumns(columns)
-	}
 
-	if v, ok := d.GetOk("expose_progress"); ok {
-		e := materialize.GetIdentifierSchemaStruct(v)
-		b.ExposeProgress(e)
-	}
+		if v, ok := d.GetOk("ignore_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("ignore_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.IgnoreColumns(columns)
+		}
 
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("text_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.TextColumns(columns)
+		}
 
-	// Handle ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("expose_progress"); ok {
+			e := materialize.GetIdentifierSchemaStruct(v)
+			b.ExposeProgress(e)
+		}
 
-	// Handle comments
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
-	i, err := materialize.SourceId(metaDb, o)
+	i, err := materialize.SourceId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,13 +186,12 @@ func sourceMySQLCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 }
 
 func sourceMySQLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSource(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseSource, materialize.SourceId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -202,7 +199,15 @@ func sourceMySQLRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanSource(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SourceName.String))
 
 	if err := d.Set("name", s.SourceName.String); err != nil {
 		return diag.FromErr(err)
@@ -250,7 +255,7 @@ func sourceMySQLRead(ctx context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 
-	deps, err := materialize.ListMysqlSubsources(metaDb, utils.ExtractId(i), "subsource")
+	deps, err := materialize.ListMysqlSubsources(ctx, metaDb, utils.ExtractId(i), "subsource")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -283,12 +288,12 @@ func sourceMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSource(metaDb, o)
+		b := materialize.NewSource(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -296,7 +301,7 @@ func sourceMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -327,7 +332,7 @@ func sourceMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -347,7 +352,7 @@ func sourceMySQLDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if err := b.DropCascade(); err != nil {
 		return diag.FromErr(err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourcePostgresSchema = map[string]*schema.Schema{
@@ -82,7 +83,57 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 				},
 			},
 		},
-		Optional: true,
+		Optional:      true,
+		ConflictsWith: []string{"all_tables", "schemas"},
+	},
+	"all_tables": {
+		Description:   "Create subsources for all tables in the publication. The resulting subsources are exposed in the `subsource` attribute. Conflicts with `table` and `schemas`. It is not recorded in the catalog, so it cannot be imported.",
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"table", "schemas"},
+	},
+	"schemas": {
+		Description:   "Create subsources for all tables in the publication that belong to the specified upstream schemas. The resulting subsources are exposed in the `subsource` attribute. Conflicts with `table` and `all_tables`. It is not recorded in the catalog, so it cannot be imported.",
+		Type:          schema.TypeList,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"table", "all_tables"},
+	},
+	"subsource": {
+		Description: "The subsources created by the source, as discovered from the catalog.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"upstream_name": {
+					Description: "The name of the table in the upstream Postgres database.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"upstream_schema_name": {
+					Description: "The schema of the table in the upstream Postgres database.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"name": {
+					Description: "The name of the subsource in Materialize.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"schema_name": {
+					Description: "The schema of the subsource in Materialize.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"database_name": {
+					Description: "The database of the subsource in Materialize.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
 	},
 	"expose_progress": IdentifierSchema(IdentifierSchemaParams{
 		Elem:        "expose_progress",
@@ -90,8 +141,11 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("source"),
+	"region":           RegionSchema(),
 }
 
 func SourcePostgres() *schema.Resource {
@@ -100,11 +154,11 @@ func SourcePostgres() *schema.Resource {
 
 		CreateContext: sourcePostgresCreate,
 		ReadContext:   sourcePostgresRead,
-		UpdateContext: sourcePostgresUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSource, materialize.SourceId, sourcePostgresUpdate),
 		DeleteContext: sourcePostgresDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSource, materialize.SourceId),
 		},
 
 		Schema: sourcePostgresSchema,
@@ -112,13 +166,12 @@ func SourcePostgres() *schema.Resource {
 }
 
 func sourcePostgresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSource(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseSource, materialize.SourceId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -126,7 +179,15 @@ func sourcePostgresRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanSource(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SourceName.String))
 
 	if err := d.Set("name", s.SourceName.String); err != nil {
 		return diag.FromErr(err)
@@ -174,7 +235,7 @@ func sourcePostgresRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	deps, err := materialize.ListPostgresSubsources(metaDb, utils.ExtractId(i), "subsource")
+	deps, err := materialize.ListPostgresSubsources(ctx, metaDb, utils.ExtractId(i), "subsource")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -190,13 +251,33 @@ func sourcePostgresRead(ctx context.Context, d *schema.ResourceData, meta interf
 		tMap["database_name"] = dep.ObjectDatabaseName.String
 		tMaps = append(tMaps, tMap)
 	}
-	if err := d.Set("table", tMaps); err != nil {
+	if err := d.Set("subsource", tMaps); err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Subsources created by FOR ALL TABLES or FOR SCHEMAS are not managed
+	// through the table attribute. The catalog does not record which of them
+	// created the source, so all_tables and schemas are kept as configured and
+	// an imported source lists its subsources in table.
+	if !sourcePostgresSelectsTablesImplicitly(d) {
+		if err := d.Set("table", tMaps); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
+func sourcePostgresSelectsTablesImplicitly(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("all_tables"); ok && v.(bool) {
+		return true
+	}
+	if v, ok := d.GetOk("schemas"); ok && len(v.([]interface{})) > 0 {
+		return true
+	}
+	return false
+}
+
 func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -207,65 +288,70 @@ func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourcePostgresBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourcePostgresBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("postgres_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.PostgresConnection(conn)
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("publication"); ok {
-		b.Publication(v.(string))
-	}
+		if v, ok := d.GetOk("postgres_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.PostgresConnection(conn)
+		}
 
-	if v, ok := d.GetOk("table"); ok {
-		tables := v.(*schema.Set).List()
-		t := materialize.GetTableStruct(tables)
-		b.Table(t)
-	}
+		if v, ok := d.GetOk("publication"); ok {
+			b.Publication(v.(string))
+		}
 
-	if v, ok := d.GetOk("exclude_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("exclude_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("table"); ok {
+			tables := v.(*schema.Set).List()
+			t := materialize.GetTableStruct(tables)
+			b.Table(t)
 		}
-		b.ExcludeColumns(columns)
-	}
 
-	if v, ok := d.GetOk("expose_progress"); ok {
-		e := materialize.GetIdentifierSchemaStruct(v)
-		b.ExposeProgress(e)
-	}
+		if v, ok := d.GetOk("all_tables"); ok && v.(bool) {
+			b.AllTables()
+		}
 
-	if v, ok := d.GetOk("text_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("schemas"); ok && len(v.([]interface{})) > 0 {
+			schemas, err := materialize.GetSliceValueString("schemas", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.Schemas(schemas)
 		}
-		b.TextColumns(columns)
-	}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("exclude_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("exclude_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.ExcludeColumns(columns)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("expose_progress"); ok {
+			e := materialize.GetIdentifierSchemaStruct(v)
+			b.ExposeProgress(e)
+		}
+
+		if v, ok := d.GetOk("text_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.TextColumns(columns)
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SourceId(metaDb, o)
+	i, err := materialize.SourceId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -284,12 +370,12 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSource(metaDb, o)
+		b := materialize.NewSource(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -297,7 +383,7 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -328,7 +414,7 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -348,7 +434,7 @@ func sourcePostgresDelete(ctx context.Context, d *schema.ResourceData, meta any)
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if err := b.DropCascade(); err != nil {
 		return diag.FromErr(err)
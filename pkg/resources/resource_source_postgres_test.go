@@ -213,3 +213,140 @@ func TestResourceSourcePostgresCreateWithTextAndExcludeColumns(t *testing.T) {
 		}
 	})
 }
+
+var inSourcePostgresSchemas = map[string]interface{}{
+	"name":          "source",
+	"schema_name":   "schema",
+	"database_name": "database",
+	"cluster_name":  "cluster",
+	"postgres_connection": []interface{}{
+		map[string]interface{}{
+			"name": "pg_connection",
+		},
+	},
+	"publication": "mz_source",
+	"schemas":     []interface{}{"public", "app"},
+}
+
+func TestResourceSourcePostgresCreateSchemas(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, inSourcePostgresSchemas)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM POSTGRES CONNECTION "materialize"."public"."pg_connection" \(PUBLICATION 'mz_source'\) FOR SCHEMAS \("public", "app"\)`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		pt := `WHERE mz_object_dependencies.referenced_object_id = 'u1' AND mz_sources.type = 'subsource'`
+		testhelpers.MockPosgresSubsourceScan(mock, pt)
+
+		if err := sourcePostgresCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Len(d.Get("subsource").([]interface{}), 1)
+		r.Empty(d.Get("table").(*schema.Set).List())
+	})
+}
+
+func TestResourceSourcePostgresCreateAllTables(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "source",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"postgres_connection": []interface{}{
+			map[string]interface{}{
+				"name": "pg_connection",
+			},
+		},
+		"publication": "mz_source",
+		"all_tables":  true,
+	}
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" FROM POSTGRES CONNECTION "materialize"."public"."pg_connection" \(PUBLICATION 'mz_source'\) FOR ALL TABLES`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		pt := `WHERE mz_object_dependencies.referenced_object_id = 'u1' AND mz_sources.type = 'subsource'`
+		testhelpers.MockPosgresSubsourceScan(mock, pt)
+
+		if err := sourcePostgresCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourcePostgresReadAllTables(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "source",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"publication":   "mz_source",
+		"all_tables":    true,
+	}
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockSourceScan(mock, `WHERE mz_sources.id = 'u1'`)
+		testhelpers.MockPosgresSubsourceScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1' AND mz_sources.type = 'subsource'`)
+
+		if err := sourcePostgresRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// The subsources are read back without being taken for the table
+		// attribute
+		r.True(d.Get("all_tables").(bool))
+		r.Len(d.Get("subsource").([]interface{}), 1)
+		r.Empty(d.Get("table").(*schema.Set).List())
+	})
+}
+
+func TestResourceSourcePostgresReadImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, map[string]interface{}{})
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockSourceScan(mock, `WHERE mz_sources.id = 'u1'`)
+		testhelpers.MockPosgresSubsourceScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1' AND mz_sources.type = 'subsource'`)
+
+		if err := sourcePostgresRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// all_tables and schemas cannot be read back, the subsources of an
+		// imported source are listed in table
+		r.False(d.Get("all_tables").(bool))
+		r.Empty(d.Get("schemas").([]interface{}))
+		r.Len(d.Get("subsource").([]interface{}), 1)
+		r.Len(d.Get("table").(*schema.Set).List(), 1)
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourceSQLServerSchema = map[string]*schema.Schema{
@@ -91,8 +92,11 @@ var sourceSQLServerSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    false,
 	}),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("source"),
+	"region":           RegionSchema(),
 }
 
 func SourceSQLServer() *schema.Resource {
@@ -101,11 +105,11 @@ func SourceSQLServer() *schema.Resource {
 
 		CreateContext: sourceSQLServerCreate,
 		ReadContext:   sourceSQLServerRead,
-		UpdateContext: sourceSQLServerUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSource, materialize.SourceId, sourceSQLServerUpdate),
 		DeleteContext: sourceSQLServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSource, materialize.SourceId),
 		},
 
 		Schema: sourceSQLServerSchema,
@@ -123,64 +127,58 @@ func sourceSQLServerCreate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceSQLServerBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("cluster_name"); ok {
-		b.ClusterName(v.(string))
-	}
-
-	if v, ok := d.GetOk("sqlserver_connection"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.SQLServerConnection(conn)
-	}
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceSQLServerBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("table"); ok {
-		tables := v.(*schema.Set).List()
-		t := materialize.GetTableStruct(tables)
-		b.Table(t)
-	}
+		if v, ok := d.GetOk("cluster_name"); ok {
+			b.ClusterName(v.(string))
+		}
 
-	if v, ok := d.GetOk("exclude_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("exclude_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("sqlserver_connection"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.SQLServerConnection(conn)
 		}
-		b.ExcludeColumns(columns)
-	}
 
-	if v, ok := d.GetOk("text_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("table"); ok {
+			tables := v.(*schema.Set).List()
+			t := materialize.GetTableStruct(tables)
+			b.Table(t)
 		}
-		b.TextColumns(columns)
-	}
 
-	if v, ok := d.GetOk("expose_progress"); ok {
-		e := materialize.GetIdentifierSchemaStruct(v)
-		b.ExposeProgress(e)
-	}
+		if v, ok := d.GetOk("exclude_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("exclude_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.ExcludeColumns(columns)
+		}
 
-	if v, ok := d.GetOk("aws_privatelink"); ok {
-		conn := materialize.GetIdentifierSchemaStruct(v)
-		b.AWSPrivateLink(conn)
-	}
+		if v, ok := d.GetOk("text_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.TextColumns(columns)
+		}
 
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("expose_progress"); ok {
+			e := materialize.GetIdentifierSchemaStruct(v)
+			b.ExposeProgress(e)
+		}
 
-	// Handle ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("aws_privatelink"); ok {
+			conn := materialize.GetIdentifierSchemaStruct(v)
+			b.AWSPrivateLink(conn)
+		}
 
-	// Handle comments
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
-	i, err := materialize.SourceId(metaDb, o)
+	i, err := materialize.SourceId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -190,13 +188,12 @@ func sourceSQLServerCreate(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func sourceSQLServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanSource(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseSource, materialize.SourceId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -204,7 +201,15 @@ func sourceSQLServerRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanSource(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.SourceName.String))
 
 	if err := d.Set("name", s.SourceName.String); err != nil {
 		return diag.FromErr(err)
@@ -252,7 +257,7 @@ func sourceSQLServerRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	deps, err := materialize.ListSQLServerSubsources(metaDb, utils.ExtractId(i), "subsource")
+	deps, err := materialize.ListSQLServerSubsources(ctx, metaDb, utils.ExtractId(i), "subsource")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -285,12 +290,12 @@ func sourceSQLServerUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSource(metaDb, o)
+		b := materialize.NewSource(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -298,7 +303,7 @@ func sourceSQLServerUpdate(ctx context.Context, d *schema.ResourceData, meta any
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -329,7 +334,7 @@ func sourceSQLServerUpdate(ctx context.Context, d *schema.ResourceData, meta any
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -349,7 +354,7 @@ func sourceSQLServerDelete(ctx context.Context, d *schema.ResourceData, meta any
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSource(metaDb, o)
+	b := materialize.NewSource(ctx, metaDb, o)
 
 	if err := b.DropCascade(); err != nil {
 		return diag.FromErr(err)
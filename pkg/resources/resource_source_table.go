@@ -19,7 +19,7 @@ func sourceTableRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	t, err := materialize.ScanSourceTable(metaDb, utils.ExtractId(i))
+	t, err := materialize.ScanSourceTable(ctx, metaDb, utils.ExtractId(i))
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -78,7 +78,7 @@ func sourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSourceTableBuilder(metaDb, o)
+		b := materialize.NewSourceTableBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -86,7 +86,7 @@ func sourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -95,7 +95,7 @@ func sourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -116,7 +116,7 @@ func sourceTableDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceTableBuilder(metaDb, o)
+	b := materialize.NewSourceTableBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 func withRequiredWith(s *schema.Schema, requiredWith []string) *schema.Schema {
@@ -180,8 +181,11 @@ var sourceTableKafkaSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("table"),
+	"region":           RegionSchema(),
 }
 
 func SourceTableKafka() *schema.Resource {
@@ -190,11 +194,11 @@ func SourceTableKafka() *schema.Resource {
 
 		CreateContext: sourceTableKafkaCreate,
 		ReadContext:   sourceTableKafkaRead,
-		UpdateContext: sourceTableKafkaUpdate,
+		UpdateContext: updateByCatalogId(materialize.Table, materialize.SourceTableKafkaId, sourceTableKafkaUpdate),
 		DeleteContext: sourceTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.Table, materialize.SourceTableKafkaId),
 		},
 
 		Schema: sourceTableKafkaSchema,
@@ -211,95 +215,88 @@ func sourceTableKafkaCreate(ctx context.Context, d *schema.ResourceData, meta an
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceTableKafkaBuilder(metaDb, o)
 
-	source := materialize.GetIdentifierSchemaStruct(d.Get("source"))
-	b.Source(source)
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceTableKafkaBuilder(ctx, db, o)
 
-	b.UpstreamName(d.Get("topic").(string))
+		source := materialize.GetIdentifierSchemaStruct(d.Get("source"))
+		b.Source(source)
 
-	if v, ok := d.GetOk("include_key"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_key_alias"); ok {
-			b.IncludeKeyAlias(alias.(string))
-		} else {
-			b.IncludeKey()
-		}
-	}
+		b.UpstreamName(d.Get("topic").(string))
 
-	if v, ok := d.GetOk("include_partition"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_partition_alias"); ok {
-			b.IncludePartitionAlias(alias.(string))
-		} else {
-			b.IncludePartition()
+		if v, ok := d.GetOk("include_key"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_key_alias"); ok {
+				b.IncludeKeyAlias(alias.(string))
+			} else {
+				b.IncludeKey()
+			}
 		}
-	}
 
-	if v, ok := d.GetOk("include_offset"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_offset_alias"); ok {
-			b.IncludeOffsetAlias(alias.(string))
-		} else {
-			b.IncludeOffset()
+		if v, ok := d.GetOk("include_partition"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_partition_alias"); ok {
+				b.IncludePartitionAlias(alias.(string))
+			} else {
+				b.IncludePartition()
+			}
 		}
-	}
 
-	if v, ok := d.GetOk("include_timestamp"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_timestamp_alias"); ok {
-			b.IncludeTimestampAlias(alias.(string))
-		} else {
-			b.IncludeTimestamp()
+		if v, ok := d.GetOk("include_offset"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_offset_alias"); ok {
+				b.IncludeOffsetAlias(alias.(string))
+			} else {
+				b.IncludeOffset()
+			}
 		}
-	}
 
-	if v, ok := d.GetOk("include_headers"); ok && v.(bool) {
-		if alias, ok := d.GetOk("include_headers_alias"); ok {
-			b.IncludeHeadersAlias(alias.(string))
-		} else {
-			b.IncludeHeaders()
+		if v, ok := d.GetOk("include_timestamp"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_timestamp_alias"); ok {
+				b.IncludeTimestampAlias(alias.(string))
+			} else {
+				b.IncludeTimestamp()
+			}
 		}
-	}
-
-	if v, ok := d.GetOk("format"); ok {
-		format := materialize.GetFormatSpecStruc(v)
-		b.Format(format)
-	}
 
-	if v, ok := d.GetOk("key_format"); ok {
-		format := materialize.GetFormatSpecStruc(v)
-		b.KeyFormat(format)
-	}
+		if v, ok := d.GetOk("include_headers"); ok && v.(bool) {
+			if alias, ok := d.GetOk("include_headers_alias"); ok {
+				b.IncludeHeadersAlias(alias.(string))
+			} else {
+				b.IncludeHeaders()
+			}
+		}
 
-	if v, ok := d.GetOk("value_format"); ok {
-		format := materialize.GetFormatSpecStruc(v)
-		b.ValueFormat(format)
-	}
+		if v, ok := d.GetOk("format"); ok {
+			format := materialize.GetFormatSpecStruc(v)
+			b.Format(format)
+		}
 
-	if v, ok := d.GetOk("envelope"); ok {
-		envelope := materialize.GetSourceKafkaEnvelopeStruct(v)
-		b.Envelope(envelope)
-	}
+		if v, ok := d.GetOk("key_format"); ok {
+			format := materialize.GetFormatSpecStruc(v)
+			b.KeyFormat(format)
+		}
 
-	if v, ok := d.GetOk("expose_progress"); ok {
-		e := materialize.GetIdentifierSchemaStruct(v)
-		b.ExposeProgress(e)
-	}
+		if v, ok := d.GetOk("value_format"); ok {
+			format := materialize.GetFormatSpecStruc(v)
+			b.ValueFormat(format)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("envelope"); ok {
+			envelope := materialize.GetSourceKafkaEnvelopeStruct(v)
+			b.Envelope(envelope)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("expose_progress"); ok {
+			e := materialize.GetIdentifierSchemaStruct(v)
+			b.ExposeProgress(e)
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.SourceTableKafkaId(metaDb, o)
+	i, err := materialize.SourceTableKafkaId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -309,14 +306,12 @@ func sourceTableKafkaCreate(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func sourceTableKafkaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	t, err := materialize.ScanSourceTableKafka(metaDb, utils.ExtractId(i))
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Table, materialize.SourceTableKafkaId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -324,7 +319,15 @@ func sourceTableKafkaRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	t, err := materialize.ScanSourceTableKafka(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, t.DatabaseName.String, t.SchemaName.String, t.TableName.String))
 
 	if err := d.Set("name", t.TableName.String); err != nil {
 		return diag.FromErr(err)
@@ -381,7 +384,7 @@ func sourceTableKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSourceTableKafkaBuilder(metaDb, o)
+		b := materialize.NewSourceTableKafkaBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -389,7 +392,7 @@ func sourceTableKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -398,7 +401,7 @@ func sourceTableKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
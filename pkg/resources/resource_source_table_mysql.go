@@ -3,13 +3,13 @@ package resources
 import (
 	"context"
 	"database/sql"
-	"log"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourceTableMySQLSchema = map[string]*schema.Schema{
@@ -49,20 +49,23 @@ var sourceTableMySQLSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"comment":        CommentSchema(false),
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"comment":          CommentSchema(false),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("table"),
+	"region":           RegionSchema(),
 }
 
 func SourceTableMySQL() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: sourceTableMySQLCreate,
 		ReadContext:   sourceTableMySQLRead,
-		UpdateContext: sourceTableMySQLUpdate,
+		UpdateContext: updateByCatalogId(materialize.Table, materialize.SourceTableMySQLId, sourceTableMySQLUpdate),
 		DeleteContext: sourceTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.Table, materialize.SourceTableMySQLId),
 		},
 
 		Schema: sourceTableMySQLSchema,
@@ -80,58 +83,42 @@ func sourceTableMySQLCreate(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceTableMySQLBuilder(metaDb, o)
 
-	source := materialize.GetIdentifierSchemaStruct(d.Get("source"))
-	b.Source(source)
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceTableMySQLBuilder(ctx, db, o)
 
-	b.UpstreamName(d.Get("upstream_name").(string))
+		source := materialize.GetIdentifierSchemaStruct(d.Get("source"))
+		b.Source(source)
 
-	if v, ok := d.GetOk("upstream_schema_name"); ok {
-		b.UpstreamSchemaName(v.(string))
-	}
+		b.UpstreamName(d.Get("upstream_name").(string))
 
-	if v, ok := d.GetOk("text_columns"); ok {
-		textColumns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("upstream_schema_name"); ok {
+			b.UpstreamSchemaName(v.(string))
 		}
-		b.TextColumns(textColumns)
-	}
 
-	if v, ok := d.GetOk("exclude_columns"); ok && len(v.([]interface{})) > 0 {
-		columns, err := materialize.GetSliceValueString("exclude_columns", v.([]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("text_columns"); ok {
+			textColumns, err := materialize.GetSliceValueString("text_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.TextColumns(textColumns)
 		}
-		b.ExcludeColumns(columns)
-	}
 
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Handle ownership
-	if v, ok := d.GetOk("ownership_role"); ok {
-		ownership := materialize.NewOwnershipBuilder(metaDb, o)
-		if err := ownership.Alter(v.(string)); err != nil {
-			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("exclude_columns"); ok && len(v.([]interface{})) > 0 {
+			columns, err := materialize.GetSliceValueString("exclude_columns", v.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			b.ExcludeColumns(columns)
 		}
-	}
 
-	// Handle comments
-	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
-		if err := comment.Object(v.(string)); err != nil {
-			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
-		}
+		return b, nil
+	}); diags != nil {
+		return diags
 	}
 
-	i, err := materialize.SourceTableMySQLId(metaDb, o)
+	i, err := materialize.SourceTableMySQLId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -155,7 +142,7 @@ func sourceTableMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSourceTableBuilder(metaDb, o)
+		b := materialize.NewSourceTableBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -163,7 +150,7 @@ func sourceTableMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -172,7 +159,7 @@ func sourceTableMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -183,14 +170,12 @@ func sourceTableMySQLUpdate(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func sourceTableMySQLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	t, err := materialize.ScanSourceTableMySQL(metaDb, utils.ExtractId(i))
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Table, materialize.SourceTableMySQLId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -198,7 +183,15 @@ func sourceTableMySQLRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	t, err := materialize.ScanSourceTableMySQL(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, t.DatabaseName.String, t.SchemaName.String, t.TableName.String))
 
 	if err := d.Set("name", t.TableName.String); err != nil {
 		return diag.FromErr(err)
@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"database/sql"
-	"log"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -11,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourceTableWebhookSchema = map[string]*schema.Schema{
@@ -143,8 +143,11 @@ var sourceTableWebhookSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("table"),
+	"region":           RegionSchema(),
 }
 
 func SourceTableWebhook() *schema.Resource {
@@ -153,11 +156,11 @@ func SourceTableWebhook() *schema.Resource {
 
 		CreateContext: sourceTableWebhookCreate,
 		ReadContext:   sourceTableWebhookRead,
-		UpdateContext: sourceTableWebhookUpdate,
+		UpdateContext: updateByCatalogId(materialize.Table, materialize.SourceTableWebhookId, sourceTableWebhookUpdate),
 		DeleteContext: sourceTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.Table, materialize.SourceTableWebhookId),
 		},
 
 		Schema: sourceTableWebhookSchema,
@@ -175,106 +178,89 @@ func sourceTableWebhookCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceTableWebhookBuilder(metaDb, o)
-
-	b.BodyFormat(d.Get("body_format").(string))
-
-	if v, ok := d.GetOk("include_header"); ok {
-		var headers []materialize.HeaderStruct
-		for _, header := range v.([]interface{}) {
-			h := header.(map[string]interface{})
-			headers = append(headers, materialize.HeaderStruct{
-				Header: h["header"].(string),
-				Alias:  h["alias"].(string),
-				Bytes:  h["bytes"].(bool),
-			})
-		}
-		b.IncludeHeader(headers)
-	}
-
-	if v, ok := d.GetOk("include_headers"); ok {
-		var i materialize.IncludeHeadersStruct
-		u := v.([]interface{})[0].(map[string]interface{})
-
-		if v, ok := u["all"]; ok {
-			i.All = v.(bool)
-		}
 
-		if v, ok := u["only"]; ok {
-			o, err := materialize.GetSliceValueString("only", v.([]interface{}))
-			if err != nil {
-				return diag.FromErr(err)
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceTableWebhookBuilder(ctx, db, o)
+
+		b.BodyFormat(d.Get("body_format").(string))
+
+		if v, ok := d.GetOk("include_header"); ok {
+			var headers []materialize.HeaderStruct
+			for _, header := range v.([]interface{}) {
+				h := header.(map[string]interface{})
+				headers = append(headers, materialize.HeaderStruct{
+					Header: h["header"].(string),
+					Alias:  h["alias"].(string),
+					Bytes:  h["bytes"].(bool),
+				})
 			}
-			i.Only = o
+			b.IncludeHeader(headers)
 		}
 
-		if v, ok := u["not"]; ok {
-			n, err := materialize.GetSliceValueString("not", v.([]interface{}))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			i.Not = n
-		}
-		b.IncludeHeaders(i)
-	}
+		if v, ok := d.GetOk("include_headers"); ok {
+			var i materialize.IncludeHeadersStruct
+			u := v.([]interface{})[0].(map[string]interface{})
 
-	if v, ok := d.GetOk("check_options"); ok {
-		var options []materialize.CheckOptionsStruct
-		for _, option := range v.([]interface{}) {
-			t := option.(map[string]interface{})
-			fieldMap := t["field"].([]interface{})[0].(map[string]interface{})
-
-			var secret = materialize.IdentifierSchemaStruct{}
-			if secretMap, ok := fieldMap["secret"].([]interface{}); ok && len(secretMap) > 0 && secretMap[0] != nil {
-				secret = materialize.GetIdentifierSchemaStruct(secretMap)
+			if v, ok := u["all"]; ok {
+				i.All = v.(bool)
 			}
 
-			field := materialize.FieldStruct{
-				Body:    fieldMap["body"].(bool),
-				Headers: fieldMap["headers"].(bool),
-				Secret:  secret,
+			if v, ok := u["only"]; ok {
+				o, err := materialize.GetSliceValueString("only", v.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				i.Only = o
 			}
 
-			options = append(options, materialize.CheckOptionsStruct{
-				Field: field,
-				Alias: t["alias"].(string),
-				Bytes: t["bytes"].(bool),
-			})
+			if v, ok := u["not"]; ok {
+				n, err := materialize.GetSliceValueString("not", v.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				i.Not = n
+			}
+			b.IncludeHeaders(i)
 		}
-		b.CheckOptions(options)
-	}
-
-	if v, ok := d.GetOk("check_expression"); ok {
-		b.CheckExpression(v.(string))
-	}
-
-	// Create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
 
-	// Handle ownership
-	if v, ok := d.GetOk("ownership_role"); ok {
-		ownership := materialize.NewOwnershipBuilder(metaDb, o)
-		if err := ownership.Alter(v.(string)); err != nil {
-			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("check_options"); ok {
+			var options []materialize.CheckOptionsStruct
+			for _, option := range v.([]interface{}) {
+				t := option.(map[string]interface{})
+				fieldMap := t["field"].([]interface{})[0].(map[string]interface{})
+
+				var secret = materialize.IdentifierSchemaStruct{}
+				if secretMap, ok := fieldMap["secret"].([]interface{}); ok && len(secretMap) > 0 && secretMap[0] != nil {
+					secret = materialize.GetIdentifierSchemaStruct(secretMap)
+				}
+
+				field := materialize.FieldStruct{
+					Body:    fieldMap["body"].(bool),
+					Headers: fieldMap["headers"].(bool),
+					Secret:  secret,
+				}
+
+				options = append(options, materialize.CheckOptionsStruct{
+					Field: field,
+					Alias: t["alias"].(string),
+					Bytes: t["bytes"].(bool),
+				})
+			}
+			b.CheckOptions(options)
 		}
-	}
 
-	// Handle comments
-	if v, ok := d.GetOk("comment"); ok {
-		comment := materialize.NewCommentBuilder(metaDb, o)
-		if err := comment.Object(v.(string)); err != nil {
-			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
-			b.Drop()
-			return diag.FromErr(err)
+		if v, ok := d.GetOk("check_expression"); ok {
+			b.CheckExpression(v.(string))
 		}
+
+		return b, nil
+	}); diags != nil {
+		return diags
 	}
 
 	// Set ID
-	i, err := materialize.SourceTableWebhookId(metaDb, o)
+	i, err := materialize.SourceTableWebhookId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -284,14 +270,12 @@ func sourceTableWebhookCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func sourceTableWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	t, err := materialize.ScanSourceTableWebhook(metaDb, utils.ExtractId(i))
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Table, materialize.SourceTableWebhookId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -299,7 +283,15 @@ func sourceTableWebhookRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	t, err := materialize.ScanSourceTableWebhook(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, t.DatabaseName.String, t.SchemaName.String, t.TableName.String))
 
 	if err := d.Set("name", t.TableName.String); err != nil {
 		return diag.FromErr(err)
@@ -339,7 +331,7 @@ func sourceTableWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewSourceTableBuilder(metaDb, o)
+		b := materialize.NewSourceTableBuilder(ctx, metaDb, o)
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -347,7 +339,7 @@ func sourceTableWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -356,7 +348,7 @@ func sourceTableWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
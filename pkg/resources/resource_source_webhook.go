@@ -2,12 +2,15 @@ package resources
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
 )
 
 var sourceWebhookSchema = map[string]*schema.Schema{
@@ -28,7 +31,7 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		Computed:    true,
 	},
 	"url": {
-		Description: "The webhook URL that can be used to send data to this source.",
+		Description: "The webhook URL that can be used to send data to this source. The URL is derived from the database, schema and source name, so it stays the same when the source is recreated and only changes when the source is renamed or moved. Plans show the new URL when the source is renamed or moved, unless a name contains characters other than letters, digits and underscores, in which case the URL is known only after apply.",
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
@@ -44,7 +47,7 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		}, true),
 	},
 	"include_header": {
-		Description: "Map a header value from a request into a column.",
+		Description: "Map a header value from a request into a column. Materialize does not support altering this in place, so changing it recreates the source. The `url` is unchanged by the recreation.",
 		Type:        schema.TypeList,
 		Optional:    true,
 		Elem: &schema.Resource{
@@ -104,7 +107,7 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"check_options": {
-		Description: "The check options for the webhook.",
+		Description: "The check options for the webhook. Materialize does not support altering this in place, so changing it recreates the source. The `url` is unchanged by the recreation.",
 		Type:        schema.TypeList,
 		Optional:    true,
 		Elem: &schema.Resource{
@@ -151,33 +154,91 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"check_expression": {
-		Description: "The check expression for the webhook.",
+		Description: "The check expression for the webhook. Materialize does not support altering this in place, so changing it recreates the source. The `url` is unchanged by the recreation.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("source"),
+	"region":           RegionSchema(),
 }
 
 func SourceWebhook() *schema.Resource {
 	return &schema.Resource{
-		Description:        "A webhook source describes a webhook you want Materialize to read data from. **Deprecated:** This resource is deprecated and will be removed in a future release. Use `materialize_source_table_webhook` instead.",
+		Description:        "A webhook source describes a webhook you want Materialize to read data from. To rotate a secret used in `check_options`, update the `value` of the referenced `materialize_secret`, which alters the secret in place without recreating the source. **Deprecated:** This resource is deprecated and will be removed in a future release. Use `materialize_source_table_webhook` instead.",
 		DeprecationMessage: "This resource is deprecated and will be removed in a future release. Use materialize_source_table_webhook instead.",
 
 		CreateContext: sourceWebhookCreate,
 		ReadContext:   sourceRead,
-		UpdateContext: sourceUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseSource, materialize.SourceId, sourceUpdate),
 		DeleteContext: sourceDelete,
 
+		CustomizeDiff: sourceWebhookCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseSource, materialize.SourceId),
 		},
 
 		Schema: sourceWebhookSchema,
 	}
 }
 
+// plainUrlSegment matches the names that appear unchanged in a webhook URL.
+var plainUrlSegment = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// webhookUrl rebuilds a webhook URL for a new database, schema and source name,
+// keeping the host of the existing URL. Names that Materialize would have to
+// encode are not rebuilt, since the encoding is not part of its interface.
+func webhookUrl(oldUrl, databaseName, schemaName, sourceName string) (string, bool) {
+	i := strings.Index(oldUrl, "/api/webhook/")
+	if i < 0 {
+		return "", false
+	}
+
+	segments := []string{databaseName, schemaName, sourceName}
+	for _, s := range segments {
+		if !plainUrlSegment.MatchString(s) {
+			return "", false
+		}
+	}
+	return oldUrl[:i] + "/api/webhook/" + strings.Join(segments, "/"), true
+}
+
+// sourceWebhookCustomizeDiff plans the webhook URL from the URL in state, so
+// that recreating the source shows an unchanged URL and renaming it shows the
+// new one. If the new URL cannot be rebuilt it is known only after apply.
+func sourceWebhookCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The prior state is read raw since the SDK clears it when the source is
+	// being replaced.
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() {
+		return nil
+	}
+	oldUrl := state.GetAttr("url")
+	if oldUrl.IsNull() || !oldUrl.IsKnown() {
+		return nil
+	}
+
+	for _, k := range []string{"name", "schema_name", "database_name"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	if !d.HasChanges("name", "schema_name", "database_name") {
+		return d.SetNew("url", oldUrl.AsString())
+	}
+
+	newUrl, ok := webhookUrl(oldUrl.AsString(), d.Get("database_name").(string), d.Get("schema_name").(string), d.Get("name").(string))
+	if !ok {
+		return d.SetNewComputed("url")
+	}
+	return d.SetNew("url", newUrl)
+}
+
 func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -191,92 +252,86 @@ func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseSource, Name: sourceName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewSourceWebhookBuilder(metaDb, o)
 
-	b.ClusterName(clusterName).
-		BodyFormat(bodyFormat).
-		CheckExpression(d.Get("check_expression").(string))
+	// create resource with its ownership and comment
+	if diags := createObject(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewSourceWebhookBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("include_header"); ok {
-		var headers []materialize.HeaderStruct
-		for _, header := range v.([]interface{}) {
-			h := header.(map[string]interface{})
-			headers = append(headers, materialize.HeaderStruct{
-				Header: h["header"].(string),
-				Alias:  h["alias"].(string),
-				Bytes:  h["bytes"].(bool),
-			})
-		}
-		b.IncludeHeader(headers)
-	}
+		b.ClusterName(clusterName).
+			BodyFormat(bodyFormat).
+			CheckExpression(d.Get("check_expression").(string))
 
-	if v, ok := d.GetOk("include_headers"); ok {
-		var i materialize.IncludeHeadersStruct
-		u := v.([]interface{})[0].(map[string]interface{})
-		if v, ok := u["all"]; ok {
-			i.All = v.(bool)
+		if v, ok := d.GetOk("include_header"); ok {
+			var headers []materialize.HeaderStruct
+			for _, header := range v.([]interface{}) {
+				h := header.(map[string]interface{})
+				headers = append(headers, materialize.HeaderStruct{
+					Header: h["header"].(string),
+					Alias:  h["alias"].(string),
+					Bytes:  h["bytes"].(bool),
+				})
+			}
+			b.IncludeHeader(headers)
 		}
 
-		if v, ok := u["only"]; ok {
-			o, err := materialize.GetSliceValueString("only", v.([]interface{}))
-			if err != nil {
-				return diag.FromErr(err)
+		if v, ok := d.GetOk("include_headers"); ok {
+			var i materialize.IncludeHeadersStruct
+			u := v.([]interface{})[0].(map[string]interface{})
+			if v, ok := u["all"]; ok {
+				i.All = v.(bool)
 			}
-			i.Only = o
-		}
 
-		if v, ok := u["not"]; ok {
-			n, err := materialize.GetSliceValueString("not", v.([]interface{}))
-			if err != nil {
-				return diag.FromErr(err)
+			if v, ok := u["only"]; ok {
+				o, err := materialize.GetSliceValueString("only", v.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				i.Only = o
 			}
-			i.Not = n
+
+			if v, ok := u["not"]; ok {
+				n, err := materialize.GetSliceValueString("not", v.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				i.Not = n
+			}
+			b.IncludeHeaders(i)
 		}
-		b.IncludeHeaders(i)
-	}
 
-	if v, ok := d.GetOk("check_options"); ok {
-		var options []materialize.CheckOptionsStruct
-		for _, option := range v.([]interface{}) {
-			t := option.(map[string]interface{})
-			fieldMap := t["field"].([]interface{})[0].(map[string]interface{})
+		if v, ok := d.GetOk("check_options"); ok {
+			var options []materialize.CheckOptionsStruct
+			for _, option := range v.([]interface{}) {
+				t := option.(map[string]interface{})
+				fieldMap := t["field"].([]interface{})[0].(map[string]interface{})
 
-			var secret = materialize.IdentifierSchemaStruct{}
-			if secretMap, ok := fieldMap["secret"].([]interface{}); ok && len(secretMap) > 0 && secretMap[0] != nil {
-				secret = materialize.GetIdentifierSchemaStruct(secretMap)
-			}
+				var secret = materialize.IdentifierSchemaStruct{}
+				if secretMap, ok := fieldMap["secret"].([]interface{}); ok && len(secretMap) > 0 && secretMap[0] != nil {
+					secret = materialize.GetIdentifierSchemaStruct(secretMap)
+				}
 
-			field := materialize.FieldStruct{
-				Body:    fieldMap["body"].(bool),
-				Headers: fieldMap["headers"].(bool),
-				Secret:  secret,
-			}
+				field := materialize.FieldStruct{
+					Body:    fieldMap["body"].(bool),
+					Headers: fieldMap["headers"].(bool),
+					Secret:  secret,
+				}
 
-			options = append(options, materialize.CheckOptionsStruct{
-				Field: field,
-				Alias: t["alias"].(string),
-				Bytes: t["bytes"].(bool),
-			})
+				options = append(options, materialize.CheckOptionsStruct{
+					Field: field,
+					Alias: t["alias"].(string),
+					Bytes: t["bytes"].(bool),
+				})
+			}
+			b.CheckOptions(options)
 		}
-		b.CheckOptions(options)
-	}
-	// Create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// Set id
-	i, err := materialize.SourceId(metaDb, o)
+	i, err := materialize.SourceId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,7 +8,9 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -64,3 +66,111 @@ func TestResourceSourceWebhookCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestWebhookUrl(t *testing.T) {
+	r := require.New(t)
+
+	u, ok := webhookUrl("https://host.materialize.cloud/api/webhook/database/schema/webhook_source", "db", "Schema_1", "new_source")
+	r.True(ok)
+	r.Equal("https://host.materialize.cloud/api/webhook/db/Schema_1/new_source", u)
+
+	_, ok = webhookUrl("https://host.materialize.cloud/other", "db", "schema", "source")
+	r.False(ok)
+
+	for _, n := range []string{"my schema", "new+source", "source-1", "ünicode", ""} {
+		_, ok = webhookUrl("https://host.materialize.cloud/api/webhook/database/schema/webhook_source", "db", "schema", n)
+		r.False(ok, n)
+	}
+}
+
+func sourceWebhookDiff(t *testing.T, config map[string]interface{}) *terraform.InstanceDiff {
+	t.Helper()
+	r := require.New(t)
+
+	res := SourceWebhook()
+	oldUrl := "https://host.materialize.cloud/api/webhook/database/schema/webhook_source"
+
+	attrs := map[string]cty.Value{}
+	for k, ty := range res.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		attrs[k] = cty.NullVal(ty)
+	}
+	attrs["id"] = cty.StringVal("aws/us-east-1:u1")
+	attrs["name"] = cty.StringVal("webhook_source")
+	attrs["schema_name"] = cty.StringVal("schema")
+	attrs["database_name"] = cty.StringVal("database")
+	attrs["cluster_name"] = cty.StringVal("cluster")
+	attrs["check_expression"] = cty.StringVal("check_expression")
+	attrs["url"] = cty.StringVal(oldUrl)
+
+	state := &terraform.InstanceState{
+		ID: "aws/us-east-1:u1",
+		Attributes: map[string]string{
+			"id":               "aws/us-east-1:u1",
+			"name":             "webhook_source",
+			"schema_name":      "schema",
+			"database_name":    "database",
+			"cluster_name":     "cluster",
+			"check_expression": "check_expression",
+			"url":              oldUrl,
+		},
+		RawState: cty.ObjectVal(attrs),
+	}
+
+	diff, err := res.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(config), nil)
+	r.NoError(err)
+	r.NotNil(diff)
+	return diff
+}
+
+func TestResourceSourceWebhookDiffRecreateKeepsUrl(t *testing.T) {
+	r := require.New(t)
+
+	diff := sourceWebhookDiff(t, map[string]interface{}{
+		"name":             "webhook_source",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"cluster_name":     "cluster",
+		"check_expression": "new_check_expression",
+	})
+
+	r.True(diff.RequiresNew())
+	u := diff.Attributes["url"]
+	r.NotNil(u)
+	r.False(u.NewComputed)
+	r.Equal("https://host.materialize.cloud/api/webhook/database/schema/webhook_source", u.New)
+}
+
+func TestResourceSourceWebhookDiffRenameChangesUrl(t *testing.T) {
+	r := require.New(t)
+
+	diff := sourceWebhookDiff(t, map[string]interface{}{
+		"name":             "new_webhook_source",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"cluster_name":     "cluster",
+		"check_expression": "check_expression",
+	})
+
+	r.False(diff.RequiresNew())
+	u := diff.Attributes["url"]
+	r.NotNil(u)
+	r.Equal("https://host.materialize.cloud/api/webhook/database/schema/webhook_source", u.Old)
+	r.Equal("https://host.materialize.cloud/api/webhook/database/schema/new_webhook_source", u.New)
+}
+
+func TestResourceSourceWebhookDiffRenameEncodedUrl(t *testing.T) {
+	r := require.New(t)
+
+	diff := sourceWebhookDiff(t, map[string]interface{}{
+		"name":             "new webhook source",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"cluster_name":     "cluster",
+		"check_expression": "check_expression",
+	})
+
+	r.False(diff.RequiresNew())
+	u := diff.Attributes["url"]
+	r.NotNil(u)
+	r.True(u.NewComputed)
+}
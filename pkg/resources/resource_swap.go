@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+var swapSchema = map[string]*schema.Schema{
+	"object_type": {
+		Description:  "The type of the objects to swap. Accepts `SCHEMA` or `CLUSTER`.",
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{"SCHEMA", "CLUSTER"}, false),
+	},
+	"name": {
+		Description: "The name of the first schema or cluster.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"swap_with": {
+		Description: "The name of the schema or cluster to swap `name` with.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"database_name": {
+		Description: "The database containing both schemas. Only used when `object_type` is `SCHEMA`. Defaults to `MZ_DATABASE` environment variable if set or `materialize` if environment variable is not set.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		DefaultFunc: schema.EnvDefaultFunc("MZ_DATABASE", defaultDatabase),
+	},
+	"triggers": {
+		Description: "Arbitrary values that perform the swap again whenever they change, such as the version being promoted.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"name_id": {
+		Description: "The ID of the object that currently holds `name`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"swap_with_id": {
+		Description: "The ID of the object that currently holds `swap_with`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"region": RegionSchema(),
+}
+
+func Swap() *schema.Resource {
+	return &schema.Resource{
+		Description: "Atomically swaps the names of two schemas or two clusters, for blue/green deployments. " +
+			"The swap is performed when the resource is created and again whenever `triggers` changes. " +
+			"Destroying the resource does not swap the objects back.",
+
+		CreateContext: swapCreate,
+		ReadContext:   swapRead,
+		UpdateContext: swapUpdate,
+		DeleteContext: swapDelete,
+
+		Schema: swapSchema,
+	}
+}
+
+type swapObjects struct {
+	objectType   string
+	name         string
+	swapWith     string
+	databaseName string
+}
+
+func getSwapObjects(d *schema.ResourceData) swapObjects {
+	return swapObjects{
+		objectType:   d.Get("object_type").(string),
+		name:         d.Get("name").(string),
+		swapWith:     d.Get("swap_with").(string),
+		databaseName: d.Get("database_name").(string),
+	}
+}
+
+// objectId returns the ID of the object that currently holds the given name.
+func (s swapObjects) objectId(ctx context.Context, conn *sqlx.DB, name string) (string, error) {
+	if s.objectType == "CLUSTER" {
+		return materialize.ClusterId(ctx, conn, materialize.MaterializeObject{ObjectType: materialize.Cluster, Name: name})
+	}
+	return materialize.SchemaId(ctx, conn, materialize.MaterializeObject{ObjectType: materialize.Schema, Name: name, DatabaseName: s.databaseName})
+}
+
+func (s swapObjects) swap(ctx context.Context, conn *sqlx.DB) error {
+	if s.name == s.swapWith {
+		return fmt.Errorf("cannot swap %s %s with itself", strings.ToLower(s.objectType), s.name)
+	}
+	if s.objectType == "CLUSTER" {
+		return materialize.NewClusterBuilder(ctx, conn, materialize.MaterializeObject{Name: s.name}).Swap(s.swapWith)
+	}
+	return materialize.NewSchemaBuilder(ctx, conn, materialize.MaterializeObject{Name: s.name, DatabaseName: s.databaseName}).Swap(s.swapWith)
+}
+
+func (s swapObjects) key() string {
+	if s.objectType == "CLUSTER" {
+		return strings.Join([]string{"SWAP", s.objectType, s.name, s.swapWith}, "|")
+	}
+	return strings.Join([]string{"SWAP", s.objectType, s.databaseName, s.name, s.swapWith}, "|")
+}
+
+func swapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s := getSwapObjects(d)
+
+	nameId, err := s.objectId(ctx, metaDb, s.name)
+	if err == sql.ErrNoRows {
+		log.Printf("[WARN] %s %s not found, removing swap from state", strings.ToLower(s.objectType), s.name)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	swapWithId, err := s.objectId(ctx, metaDb, s.swapWith)
+	if err == sql.ErrNoRows {
+		log.Printf("[WARN] %s %s not found, removing swap from state", strings.ToLower(s.objectType), s.swapWith)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name_id", nameId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("swap_with_id", swapWithId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func swapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s := getSwapObjects(d)
+	if err := s.swap(ctx, metaDb); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), s.key()))
+
+	return swapRead(ctx, d, meta)
+}
+
+func swapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("triggers") {
+		if err := getSwapObjects(d).swap(ctx, metaDb); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return swapRead(ctx, d, meta)
+}
+
+// The swap cannot be undone by removing the resource, the objects keep the
+// names they currently have.
+func swapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var inSwapSchema = map[string]interface{}{
+	"object_type":   "SCHEMA",
+	"name":          "blue",
+	"swap_with":     "green",
+	"database_name": "database",
+	"triggers":      map[string]interface{}{"version": "2"},
+}
+
+func TestResourceSwapSchemaCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Swap().Schema, inSwapSchema)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SCHEMA "database"."blue" SWAP WITH "green";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Ids
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'blue'`)
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'green'`)
+
+		if err := swapCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:SWAP|SCHEMA|database|blue|green", d.Id())
+		r.Equal("u1", d.Get("name_id"))
+		r.Equal("u1", d.Get("swap_with_id"))
+	})
+}
+
+func TestResourceSwapClusterCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"object_type": "CLUSTER",
+		"name":        "blue",
+		"swap_with":   "green",
+	}
+	d := schema.TestResourceDataRaw(t, Swap().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CLUSTER "blue" SWAP WITH "green";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Ids
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'blue'`)
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'green'`)
+
+		if err := swapCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:SWAP|CLUSTER|blue|green", d.Id())
+	})
+}
+
+func TestResourceSwapSelf(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"object_type": "CLUSTER",
+		"name":        "blue",
+		"swap_with":   "blue",
+	}
+	d := schema.TestResourceDataRaw(t, Swap().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		diags := swapCreate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "cannot swap cluster blue with itself")
+	})
+}
+
+func TestResourceSwapUpdateTriggers(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Swap().Schema, inSwapSchema)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:SWAP|SCHEMA|database|blue|green")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SCHEMA "database"."blue" SWAP WITH "green";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'blue'`)
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'green'`)
+
+		if err := swapUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
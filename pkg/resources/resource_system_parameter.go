@@ -45,7 +45,7 @@ func systemParameterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewSystemParameterBuilder(metaDb, paramName, paramValue)
+	b := materialize.NewSystemParameterBuilder(ctx, metaDb, paramName, paramValue)
 	if err := b.Set(); err != nil {
 		return diag.FromErr(err)
 	}
@@ -63,7 +63,7 @@ func systemParameterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	paramValue, err := materialize.ShowSystemParameter(metaDb, paramName)
+	paramValue, err := materialize.ShowSystemParameter(ctx, metaDb, paramName)
 	if err != nil {
 		return diag.Errorf("error reading system parameter %s: %s", paramName, err)
 	}
@@ -93,7 +93,7 @@ func systemParameterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
-	b := materialize.NewSystemParameterBuilder(metaDb, paramName, "")
+	b := materialize.NewSystemParameterBuilder(ctx, metaDb, paramName, "")
 	if err := b.Reset(); err != nil {
 		return diag.FromErr(err)
 	}
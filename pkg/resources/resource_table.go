@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var tableSchema = map[string]*schema.Schema{
@@ -63,8 +64,11 @@ var tableSchema = map[string]*schema.Schema{
 		MinItems: 1,
 		ForceNew: true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("table"),
+	"region":           RegionSchema(),
 }
 
 func Table() *schema.Resource {
@@ -73,11 +77,11 @@ func Table() *schema.Resource {
 
 		CreateContext: tableCreate,
 		ReadContext:   tableRead,
-		UpdateContext: tableUpdate,
+		UpdateContext: updateByCatalogId(materialize.Table, materialize.TableId, tableUpdate),
 		DeleteContext: tableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.Table, materialize.TableId),
 		},
 
 		Schema: tableSchema,
@@ -85,13 +89,12 @@ func Table() *schema.Resource {
 }
 
 func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanTable(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.Table, materialize.TableId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -99,7 +102,15 @@ func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanTable(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.TableName.String))
 
 	if err := d.Set("name", s.TableName.String); err != nil {
 		return diag.FromErr(err)
@@ -127,7 +138,7 @@ func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 	}
 
 	// Table columns
-	tableColumns, err := materialize.ListTableColumns(metaDb, utils.ExtractId(i))
+	tableColumns, err := materialize.ListTableColumns(ctx, metaDb, utils.ExtractId(i))
 	if err != nil {
 		log.Print("[DEBUG] cannot query list tables")
 		return diag.FromErr(err)
@@ -160,46 +171,40 @@ func tableCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewTableBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("column"); ok {
-		columns := materialize.GetTableColumnStruct(v.([]interface{}))
-		b.Column(columns)
-	}
+	// create resource with its ownership, comment and column comments
+	if diags := createObjectInTransaction(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewTableBuilder(ctx, db, o)
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
-
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("column"); ok {
+			columns := materialize.GetTableColumnStruct(v.([]interface{}))
+			b.Column(columns)
+		}
 
-	// column comment
-	if v, ok := d.GetOk("column"); ok {
+		return b, nil
+	}, func(db *sqlx.DB) error {
+		// column comment
+		v, ok := d.GetOk("column")
+		if !ok {
+			return nil
+		}
 		columns := materialize.GetTableColumnStruct(v.([]interface{}))
-		comment := materialize.NewCommentBuilder(metaDb, o)
+		comment := materialize.NewCommentBuilder(ctx, db, o)
 
 		for _, c := range columns {
 			if c.Comment != "" {
 				if err := comment.Column(c.ColName, c.Comment); err != nil {
-					log.Printf("[DEBUG] resource failed column comment, dropping object: %s", o.Name)
-					b.Drop()
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
+		return nil
+	}); diags != nil {
+		return diags
 	}
 
 	// set id
-	i, err := materialize.TableId(metaDb, o)
+	i, err := materialize.TableId(ctx, metaDb, o)
 	if err != nil {
 		log.Printf("[DEBUG] cannot query table: %s", o.QualifiedName())
 		return diag.FromErr(err)
@@ -224,7 +229,7 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if d.HasChange("name") {
 		oldName, newName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.Table, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewTableBuilder(metaDb, o)
+		b := materialize.NewTableBuilder(ctx, metaDb, o)
 
 		if err := b.Rename(newName.(string)); err != nil {
 			return diag.FromErr(err)
@@ -233,7 +238,7 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -242,7 +247,7 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -261,7 +266,7 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			// Check specifically if the column comment has changed.
 			if newCol["comment"] != oldCol["comment"] {
 				// Apply the comment change
-				comment := materialize.NewCommentBuilder(metaDb, o)
+				comment := materialize.NewCommentBuilder(ctx, metaDb, o)
 				colName := newCol["name"].(string)
 				colComment := newCol["comment"].(string)
 				if err := comment.Column(colName, colComment); err != nil {
@@ -284,7 +289,7 @@ func tableDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewTableBuilder(metaDb, o)
+	b := materialize.NewTableBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
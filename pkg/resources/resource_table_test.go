@@ -34,6 +34,7 @@ func TestResourceTableCreate(t *testing.T) {
 
 	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
 		// Create
+		mock.ExpectBegin()
 		mock.ExpectExec(`
 			CREATE TABLE "database"."schema"."table" \(column text NOT NULL DEFAULT NULL\);
 		`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -44,6 +45,7 @@ func TestResourceTableCreate(t *testing.T) {
 		// Comment
 		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."table" IS 'object comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."table"."column" IS 'column comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
 
 		// Query Id
 		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var typeSchema = map[string]*schema.Schema{
@@ -85,8 +86,11 @@ var typeSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("type"),
+	"region":           RegionSchema(),
 }
 
 func Type() *schema.Resource {
@@ -95,11 +99,11 @@ func Type() *schema.Resource {
 
 		CreateContext: typeCreate,
 		ReadContext:   typeRead,
-		UpdateContext: typeUpdate,
+		UpdateContext: updateByCatalogId(materialize.BaseType, materialize.TypeId, typeUpdate),
 		DeleteContext: typeDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.BaseType, materialize.TypeId),
 		},
 
 		Schema: typeSchema,
@@ -107,13 +111,12 @@ func Type() *schema.Resource {
 }
 
 func typeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanType(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.BaseType, materialize.TypeId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -121,7 +124,15 @@ func typeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanType(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.TypeName.String))
 
 	if err := d.Set("name", s.TypeName.String); err != nil {
 		return diag.FromErr(err)
@@ -165,40 +176,33 @@ func typeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseType, Name: typeName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewTypeBuilder(metaDb, o)
 
-	if v, ok := d.GetOk("row_properties"); ok {
-		p := materialize.GetRowProperties(v)
-		b.RowProperties(p)
-	}
+	// create resource with its ownership and comment
+	if diags := createObjectInTransaction(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewTypeBuilder(ctx, db, o)
 
-	if v, ok := d.GetOk("list_properties"); ok {
-		p := materialize.GetListProperties(v)
-		b.ListProperties(p)
-	}
-
-	if v, ok := d.GetOk("map_properties"); ok {
-		p := materialize.GetMapProperties(v)
-		b.MapProperties(p)
-	}
+		if v, ok := d.GetOk("row_properties"); ok {
+			p := materialize.GetRowProperties(v)
+			b.RowProperties(p)
+		}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+		if v, ok := d.GetOk("list_properties"); ok {
+			p := materialize.GetListProperties(v)
+			b.ListProperties(p)
+		}
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("map_properties"); ok {
+			p := materialize.GetMapProperties(v)
+			b.MapProperties(p)
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.TypeId(metaDb, o)
+	i, err := materialize.TypeId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -217,7 +221,7 @@ func typeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.BaseType, Name: typeName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewOwnershipBuilder(metaDb, o)
+	b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
@@ -229,7 +233,7 @@ func typeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -249,7 +253,7 @@ func typeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: typeName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewTypeBuilder(metaDb, o)
+	b := materialize.NewTypeBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 var viewSchema = map[string]*schema.Schema{
@@ -28,8 +29,11 @@ var viewSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
-	"ownership_role": OwnershipRoleSchema(),
-	"region":         RegionSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"execute_as_role":  ExecuteAsRoleSchema(),
+	"session_options":  SessionOptionsSchema(),
+	"identify_by_name": IdentifyByNameSchema("view"),
+	"region":           RegionSchema(),
 }
 
 func View() *schema.Resource {
@@ -38,11 +42,11 @@ func View() *schema.Resource {
 
 		CreateContext: viewCreate,
 		ReadContext:   viewRead,
-		UpdateContext: viewUpdate,
+		UpdateContext: updateByCatalogId(materialize.View, materialize.ViewId, viewUpdate),
 		DeleteContext: viewDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByName(materialize.View, materialize.ViewId),
 		},
 
 		Schema: viewSchema,
@@ -50,13 +54,12 @@ func View() *schema.Resource {
 }
 
 func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	i := d.Id()
-
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	s, err := materialize.ScanView(metaDb, utils.ExtractId(i))
+
+	i, err := catalogId(ctx, metaDb, d.Id(), materialize.View, materialize.ViewId)
 	if err == sql.ErrNoRows {
 		d.SetId("")
 		return nil
@@ -64,7 +67,15 @@ func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
+	s, err := materialize.ScanView(ctx, metaDb, i)
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nameStateId(d, region, i, s.DatabaseName.String, s.SchemaName.String, s.ViewName.String))
 
 	if err := d.Set("name", s.ViewName.String); err != nil {
 		return diag.FromErr(err)
@@ -108,29 +119,22 @@ func viewCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: materialize.View, Name: viewName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewViewBuilder(metaDb, o)
-
-	if v, ok := d.GetOk("statement"); ok && v.(string) != "" {
-		b.SelectStmt(v.(string))
-	}
 
-	// create resource
-	if err := b.Create(); err != nil {
-		return diag.FromErr(err)
-	}
+	// create resource with its ownership and comment
+	if diags := createObjectInTransaction(ctx, d, metaDb, o, func(db *sqlx.DB) (Creatable, error) {
+		b := materialize.NewViewBuilder(ctx, db, o)
 
-	// ownership
-	if diags := applyOwnership(d, metaDb, o, b); diags != nil {
-		return diags
-	}
+		if v, ok := d.GetOk("statement"); ok && v.(string) != "" {
+			b.SelectStmt(v.(string))
+		}
 
-	// object comment
-	if diags := applyComment(d, metaDb, o, b); diags != nil {
+		return b, nil
+	}); diags != nil {
 		return diags
 	}
 
 	// set id
-	i, err := materialize.ViewId(metaDb, o)
+	i, err := materialize.ViewId(ctx, metaDb, o)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -153,7 +157,7 @@ func viewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if d.HasChange("name") {
 		oldName, newViewName := d.GetChange("name")
 		o := materialize.MaterializeObject{ObjectType: materialize.View, Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
-		b := materialize.NewViewBuilder(metaDb, o)
+		b := materialize.NewViewBuilder(ctx, metaDb, o)
 
 		if err := b.Rename(newViewName.(string)); err != nil {
 			return diag.FromErr(err)
@@ -162,7 +166,7 @@ func viewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
-		b := materialize.NewOwnershipBuilder(metaDb, o)
+		b := materialize.NewOwnershipBuilder(ctx, metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -171,7 +175,7 @@ func viewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewCommentBuilder(ctx, metaDb, o)
 
 		if err := b.Object(newComment.(string)); err != nil {
 			return diag.FromErr(err)
@@ -191,7 +195,7 @@ func viewDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{Name: viewName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewViewBuilder(metaDb, o)
+	b := materialize.NewViewBuilder(ctx, metaDb, o)
 
 	if err := b.Drop(); err != nil {
 		return diag.FromErr(err)
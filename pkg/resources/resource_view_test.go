@@ -26,7 +26,9 @@ func TestResourceViewCreate(t *testing.T) {
 
 	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
 		// Create
+		mock.ExpectBegin()
 		mock.ExpectExec(`CREATE VIEW "database"."schema"."view" AS SELECT 1 FROM 1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
 
 		// Query Id
 		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
@@ -88,6 +90,99 @@ func TestResourceViewUpdate(t *testing.T) {
 	})
 }
 
+func TestResourceViewCreateIdentifyByName(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "view",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"statement":        "SELECT 1 FROM 1",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, View().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE VIEW "database"."schema"."view" AS SELECT 1 FROM 1;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_views.id = 'u1'`
+		testhelpers.MockViewScan(mock, pp)
+
+		if err := viewCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal(`aws/us-east-1:name:"database"."schema"."view"`, d.Id())
+	})
+}
+
+func TestResourceViewReadIdentifyByName(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "view",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"statement":        "SELECT 1 FROM 1",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, View().Schema, in)
+	d.SetId(`aws/us-east-1:name:"database"."schema"."view"`)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// The view is resolved by name, so a view recreated with a new id is
+		// still found
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		pp := `WHERE mz_views.id = 'u1'`
+		testhelpers.MockViewScan(mock, pp)
+
+		if err := viewRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal(`aws/us-east-1:name:"database"."schema"."view"`, d.Id())
+		r.Equal("view", d.Get("name"))
+	})
+}
+
+func TestResourceViewUpdateIdentifyByName(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "view",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"statement":        "SELECT 1 FROM 1",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, View().Schema, in)
+
+	// Set current state
+	d.SetId(`aws/us-east-1:name:"database"."schema"."old_view"`)
+	d.Set("name", "old_view")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Resolve the id before the rename
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'old_view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."" RENAME TO "view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		pp := `WHERE mz_views.id = 'u1'`
+		testhelpers.MockViewScan(mock, pp)
+
+		if err := View().UpdateContext(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal(`aws/us-east-1:name:"database"."schema"."view"`, d.Id())
+	})
+}
+
 func TestResourceViewDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -107,3 +202,97 @@ func TestResourceViewDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceViewImportByName(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{})
+	d.SetId(`aws/us-east-1:name:database."schema".VIEW`)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		res, err := View().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(res, 1)
+		r.Equal(`aws/us-east-1:name:"database"."schema"."view"`, res[0].Id())
+		r.Equal(true, res[0].Get("identify_by_name"))
+	})
+}
+
+func TestResourceViewImportById(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{})
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		res, err := View().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Equal("aws/us-east-1:u1", res[0].Id())
+		r.Equal(false, res[0].Get("identify_by_name"))
+	})
+}
+
+func TestResourceViewImportByNameInvalid(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{})
+	d.SetId("aws/us-east-1:name:schema.view")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		_, err := View().Importer.StateContext(context.TODO(), d, db)
+		r.ErrorContains(err, "expected region:name:database.schema.object")
+	})
+}
+
+func TestResourceViewReadIdentifyByNameSeparator(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "a|b",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, View().Schema, in)
+	d.SetId(`aws/us-east-1:name:"database"."schema"."a|b"`)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Identifiers containing | are looked up whole
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'a\|b'`
+		testhelpers.MockViewScan(mock, ip)
+
+		pp := `WHERE mz_views.id = 'u1'`
+		testhelpers.MockViewScan(mock, pp)
+
+		if err := viewRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestResourceViewReadIdentifyByNameSchemaForm(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "View",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"identify_by_name": true,
+	}
+	d := schema.TestResourceDataRaw(t, View().Schema, in)
+	d.SetId("aws/us-east-1:name:database|schema|View")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// IDs in the database|schema|object form used by materialize_schema
+		// are resolved as well and rewritten to the quoted form
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'View'`
+		testhelpers.MockViewScan(mock, ip)
+
+		pp := `WHERE mz_views.id = 'u1'`
+		testhelpers.MockViewScan(mock, pp)
+
+		if err := viewRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal(`aws/us-east-1:name:"database"."schema"."view"`, d.Id())
+	})
+}
@@ -3,6 +3,8 @@ package resources
 import (
 	"fmt"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -69,6 +71,24 @@ func OwnershipRoleSchema() *schema.Schema {
 	}
 }
 
+func ExecuteAsRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Run the statements of this resource as this role, so that the object is created with it as owner without a separate provider. The role of the provider must be a member of it.",
+		Optional:    true,
+	}
+}
+
+func SessionOptionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeMap,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		Description:      "Session variables set for the statements of this resource, such as `cluster`, `search_path` or `statement_timeout`. Values with commas are set as lists.",
+		Optional:         true,
+		ValidateDiagFunc: utils.ValidateSessionOptions,
+	}
+}
+
 func QualifiedNameSchema(resource string) *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
@@ -531,6 +551,15 @@ func CommentSchema(forceNew bool) *schema.Schema {
 	}
 }
 
+func IdentifyByNameSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: fmt.Sprintf("Use the qualified %[1]s name as the resource identifier in your state file, rather than the internal %[1]s ID. Useful in blue/green deployments where objects are recreated or swapped outside of Terraform (e.g. `ALTER SCHEMA ... SWAP`), so the resource keeps tracking the %[1]s with that name when its ID changes.", resource),
+	}
+}
+
 func RegionSchema() *schema.Schema {
 	return &schema.Schema{
 		Description: "The region to use for the resource connection. If not set, the default region is used.",
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -326,7 +327,9 @@ func handleUserRequests(w http.ResponseWriter, req *http.Request, r *require.Ass
 func handleUsersV3Request(w http.ResponseWriter, req *http.Request, r *require.Assertions) {
 	email := req.URL.Query().Get("_email")
 
-	if email == "test@example.com" {
+	if email == "" {
+		handleListUsers(w, req, r)
+	} else if email == "test@example.com" {
 		user := struct {
 			ID       string `json:"id"`
 			Email    string `json:"email"`
@@ -819,6 +822,56 @@ func handleAddRolesToGroup(w http.ResponseWriter, req *http.Request, r *require.
 	}
 }
 
+// handleListUsers simulates listing the users of the organization, paginated
+// by page number.
+func handleListUsers(w http.ResponseWriter, req *http.Request, r *require.Assertions) {
+	type role struct {
+		Name string `json:"name"`
+	}
+	type user struct {
+		ID        string `json:"id"`
+		Email     string `json:"email"`
+		Name      string `json:"name"`
+		Verified  bool   `json:"verified"`
+		Provider  string `json:"provider"`
+		Roles     []role `json:"roles"`
+		CreatedAt string `json:"createdAt"`
+	}
+
+	users := []user{
+		{ID: "user-1", Email: "admin@example.com", Name: "Admin", Verified: true, Provider: "local", Roles: []role{{Name: "Organization Admin"}}, CreatedAt: "2024-01-01T00:00:00.000Z"},
+		{ID: "user-2", Email: "analyst@example.com", Name: "Analyst", Verified: true, Provider: "saml", Roles: []role{{Name: "Organization Member"}}, CreatedAt: "2024-01-02T00:00:00.000Z"},
+		{ID: "user-3", Email: "invited@other.com", Name: "Invited", Verified: false, Provider: "local", Roles: []role{{Name: "Organization Member"}}, CreatedAt: "2024-01-03T00:00:00.000Z"},
+	}
+
+	limit, err := strconv.Atoi(req.URL.Query().Get("_limit"))
+	r.NoError(err)
+	page, err := strconv.Atoi(req.URL.Query().Get("_offset"))
+	r.NoError(err)
+
+	start := page * limit
+	end := start + limit
+	if start > len(users) {
+		start = len(users)
+	}
+	if end > len(users) {
+		end = len(users)
+	}
+
+	response := struct {
+		Items    []user `json:"items"`
+		Metadata struct {
+			TotalItems int `json:"totalItems"`
+			TotalPages int `json:"totalPages"`
+		} `json:"_metadata"`
+	}{Items: users[start:end]}
+	response.Metadata.TotalItems = len(users)
+	response.Metadata.TotalPages = (len(users) + limit - 1) / limit
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
 // handleAddUsersToGroup simulates adding users to a SCIM group.
 func handleAddUsersToGroup(w http.ResponseWriter, req *http.Request, r *require.Assertions, groupID string) {
 	switch req.Method {
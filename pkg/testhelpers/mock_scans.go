@@ -477,6 +477,74 @@ func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockObjectPrivilegeScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		objects.id,
+		objects.name AS object_name,
+		objects.object_type,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		objects.privileges
+	FROM \(
+		SELECT id, name, type AS object_type, schema_id, NULL AS database_id, privileges FROM mz_relations
+		UNION ALL
+		SELECT id, name, 'connection', schema_id, NULL, privileges FROM mz_connections
+		UNION ALL
+		SELECT id, name, 'secret', schema_id, NULL, privileges FROM mz_secrets
+		UNION ALL
+		SELECT id, name, 'type', schema_id, NULL, privileges FROM mz_types
+		UNION ALL
+		SELECT id, name, 'schema', NULL, database_id, privileges FROM mz_schemas
+		UNION ALL
+		SELECT id, name, 'database', NULL, NULL, privileges FROM mz_databases
+		UNION ALL
+		SELECT id, name, 'cluster', NULL, NULL, privileges FROM mz_clusters
+		UNION ALL
+		SELECT id, name, 'network-policy', NULL, NULL, privileges FROM mz_internal.mz_network_policies
+	\) objects
+	LEFT JOIN mz_schemas
+		ON objects.schema_id = mz_schemas.id
+	LEFT JOIN mz_databases
+		ON mz_databases.id = COALESCE\(objects.database_id, mz_schemas.database_id\)`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "object_name", "object_type", "schema_name", "database_name", "privileges"}).
+		AddRow("u5", "table", "table", "schema", "database", StringArray{"s1=arwd/s1", "u1=r/s1", "u9=w/s1"}).
+		AddRow("u3", "schema", "schema", nil, "database", StringArray{"p=U/s1"}).
+		AddRow("u2", "cluster", "cluster", nil, nil, StringArray{"u2=UC/s1"})
+	if predicate == "" {
+		ir.AddRow("s1", "mz_tables", "table", "mz_catalog", nil, StringArray{"p=r/s1"})
+	}
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockDefaultPrivilegeScopeScan(mock sqlmock.Sqlmock) {
+	b := `
+	SELECT
+		mz_default_privileges.object_type,
+		mz_default_privileges.grantee AS grantee_id,
+		\(CASE WHEN mz_default_privileges.role_id = 'p' THEN 'PUBLIC' ELSE target.name END\) AS target_name,
+		COALESCE\(mz_databases.name, schema_databases.name\) AS database_name,
+		mz_schemas.name AS schema_name,
+		mz_default_privileges.privileges
+	FROM mz_default_privileges
+	LEFT JOIN mz_roles AS target
+		ON mz_default_privileges.role_id = target.id
+	LEFT JOIN mz_schemas
+		ON mz_default_privileges.schema_id = mz_schemas.id
+	LEFT JOIN mz_databases
+		ON mz_default_privileges.database_id = mz_databases.id
+	LEFT JOIN mz_databases AS schema_databases
+		ON mz_schemas.database_id = schema_databases.id`
+
+	q := mockQueryBuilder(b, "", "")
+	ir := mock.NewRows([]string{"object_type", "grantee_id", "target_name", "database_name", "schema_name", "privileges"}).
+		AddRow("table", "u1", "PUBLIC", "database", "schema", "r").
+		AddRow("table", "u9", "PUBLIC", nil, nil, "w")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockSystemPrivilege(mock sqlmock.Sqlmock) {
 	b := "SELECT privileges FROM mz_system_privileges"
 
@@ -508,6 +576,17 @@ func MockRoleScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+// MockRolePasswordUpdatedAtScan mocks the read of when the role's password
+// was last set, for a role without a password.
+func MockRolePasswordUpdatedAtScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT updated_at
+	FROM mz_internal.mz_role_auth`
+
+	q := mockQueryBuilder(b, predicate, "")
+	mock.ExpectQuery(q).WillReturnRows(mock.NewRows([]string{"updated_at"}))
+}
+
 // MockRoleScanNoRows mocks a role lookup that finds no matching role, returning
 // sql.ErrNoRows. Useful for exercising the create_if_not_exists path where the
 // role does not yet exist in the catalog.
@@ -547,6 +626,25 @@ func MockRoleGrantScan(mock sqlmock.Sqlmock) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockRoleMembersScan(mock sqlmock.Sqlmock, predicate string, members ...string) {
+	b := `
+	SELECT
+		mz_role_members.role_id,
+		mz_role_members.member,
+		mz_role_members.grantor,
+		members.name AS member_name
+	FROM mz_role_members
+	JOIN mz_roles AS members
+		ON mz_role_members.member = members.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"role_id", "member", "grantor", "member_name"})
+	for i, m := range members {
+		ir.AddRow("u1", fmt.Sprintf("u%d", i+2), "s1", m)
+	}
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockSchemaScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
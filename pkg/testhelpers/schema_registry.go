@@ -0,0 +1,116 @@
+package testhelpers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// MockSchemaRegistrySubject is a subject held by the stand-in schema registry.
+type MockSchemaRegistrySubject struct {
+	Versions []int
+	// SchemaID is the schema id of the latest version.
+	SchemaID   int
+	SchemaType string
+	// Compatibility is the subject-level override. Empty means the subject
+	// inherits the global level.
+	Compatibility string
+}
+
+// MockSchemaRegistry is an in-memory stand-in for the Confluent Schema
+// Registry REST API. Only the read endpoints used by the provider are served.
+type MockSchemaRegistry struct {
+	GlobalCompatibility string
+	Subjects            map[string]MockSchemaRegistrySubject
+	// Username and Password, when set, are required as HTTP basic auth.
+	Username string
+	Password string
+}
+
+func writeSchemaRegistryError(w http.ResponseWriter, status, code int, message string) {
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"error_code": code, "message": message})
+}
+
+func (m *MockSchemaRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if m.Username != "" || m.Password != "" {
+		u, p, ok := req.BasicAuth()
+		if !ok || u != m.Username || p != m.Password {
+			writeSchemaRegistryError(w, http.StatusUnauthorized, 40101, "Unauthorized")
+			return
+		}
+	}
+
+	if req.Method != http.MethodGet {
+		writeSchemaRegistryError(w, http.StatusMethodNotAllowed, 405, "Method Not Allowed")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	var out interface{}
+
+	switch {
+	case len(parts) == 1 && parts[0] == "subjects":
+		subjects := []string{}
+		for name := range m.Subjects {
+			subjects = append(subjects, name)
+		}
+		out = subjects
+	case len(parts) >= 3 && parts[0] == "subjects" && parts[2] == "versions":
+		s, ok := m.Subjects[parts[1]]
+		if !ok {
+			writeSchemaRegistryError(w, http.StatusNotFound, 40401, "Subject '"+parts[1]+"' not found.")
+			return
+		}
+		if len(parts) == 3 {
+			out = s.Versions
+			break
+		}
+		if parts[3] != "latest" || len(s.Versions) == 0 {
+			writeSchemaRegistryError(w, http.StatusNotFound, 40402, "Version not found.")
+			return
+		}
+		latest := map[string]interface{}{
+			"subject": parts[1],
+			"id":      s.SchemaID,
+			"version": s.Versions[len(s.Versions)-1],
+			"schema":  "{}",
+		}
+		if s.SchemaType != "" {
+			latest["schemaType"] = s.SchemaType
+		}
+		out = latest
+	case len(parts) == 1 && parts[0] == "config":
+		out = map[string]string{"compatibilityLevel": m.GlobalCompatibility}
+	case len(parts) == 2 && parts[0] == "config":
+		s, ok := m.Subjects[parts[1]]
+		if ok && s.Compatibility != "" {
+			out = map[string]string{"compatibilityLevel": s.Compatibility}
+		} else if req.URL.Query().Get("defaultToGlobal") == "true" {
+			out = map[string]string{"compatibilityLevel": m.GlobalCompatibility}
+		} else {
+			writeSchemaRegistryError(w, http.StatusNotFound, 40408, "Subject '"+parts[1]+"' does not have subject-level compatibility configured")
+			return
+		}
+	default:
+		writeSchemaRegistryError(w, http.StatusNotFound, 404, "HTTP 404 Not Found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// WithMockSchemaRegistryServer starts the stand-in schema registry and calls
+// the provided function with its URL.
+func WithMockSchemaRegistryServer(t *testing.T, registry *MockSchemaRegistry, f func(url string)) {
+	t.Helper()
+
+	server := httptest.NewServer(registry)
+	defer server.Close()
+
+	f(server.URL)
+}
@@ -56,10 +56,19 @@ type ProviderMeta struct {
 	// This allows lazy loading of roles only when SSO resources need them.
 	FronteggRolesFetcher func(ctx context.Context) (map[string]string, error)
 
+	// StatementLog records the statements run on behalf of resources, if the
+	// provider is configured with sql_audit_log.
+	StatementLog *clients.StatementLog
+
 	// fronteggRolesMu protects lazy loading of FronteggRoles
 	fronteggRolesMu sync.Mutex
 }
 
+// IsDryRun reports whether statements are recorded without being run.
+func (p *ProviderMeta) IsDryRun() bool {
+	return p.StatementLog != nil && p.StatementLog.DryRun
+}
+
 func (p *ProviderMeta) IsSelfHosted() bool {
 	return p.Mode == ModeSelfHosted
 }
@@ -157,7 +166,8 @@ func GetDBClientFromMeta(meta interface{}, d *schema.ResourceData) (*sqlx.DB, cl
 		if !exists {
 			return nil, region, fmt.Errorf("database client not initialized for self-hosted instance")
 		}
-		return dbClient.SQLX(), region, nil
+		db, err := resourceDB(dbClient, d)
+		return db, region, err
 	}
 
 	// Determine region for SaaS deployments
@@ -195,7 +205,28 @@ func GetDBClientFromMeta(meta interface{}, d *schema.ResourceData) (*sqlx.DB, cl
 		return nil, region, fmt.Errorf("no database client for region: %s", region)
 	}
 
-	return dbClient.SQLX(), region, nil
+	db, err := resourceDB(dbClient, d)
+	return db, region, err
+}
+
+// resourceDB returns the handle statements of the resource run on, which runs
+// them as execute_as_role and with session_options if the resource sets them.
+func resourceDB(dbClient *clients.DBClient, d *schema.ResourceData) (*sqlx.DB, error) {
+	if d == nil {
+		return dbClient.SQLX(), nil
+	}
+
+	session := clients.SessionConfig{}
+	if role, ok := d.GetOk("execute_as_role"); ok {
+		session.Role = role.(string)
+	}
+	if options, ok := d.GetOk("session_options"); ok {
+		session.Options = map[string]string{}
+		for k, v := range options.(map[string]interface{}) {
+			session.Options[k] = v.(string)
+		}
+	}
+	return dbClient.Session(session)
 }
 
 func SetDefaultRegion(region string) error {
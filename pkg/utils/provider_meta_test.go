@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"testing"
 	"time"
@@ -347,3 +348,108 @@ func TestProviderMeta_GetFronteggRoles_RetryAfterError(t *testing.T) {
 	r.Equal("role-1", roles["Admin"])
 	r.Equal(2, callCount) // Still 2, fetcher not called again after success
 }
+
+// sqlmockConnector opens connections to the sqlmock database registered under dsn.
+type sqlmockConnector struct {
+	dsn string
+	drv driver.Driver
+}
+
+func (c *sqlmockConnector) Connect(context.Context) (driver.Conn, error) { return c.drv.Open(c.dsn) }
+func (c *sqlmockConnector) Driver() driver.Driver                        { return c.drv }
+
+func TestGetDBClientFromMetaExecuteAsRole(t *testing.T) {
+	db, mock, err := sqlmock.NewWithDSN("execute_as_role")
+	require.NoError(t, err)
+	defer db.Close()
+
+	providerMeta := &ProviderMeta{
+		Mode: ModeSelfHosted,
+		DB: map[clients.Region]*clients.DBClient{
+			"self-hosted": clients.NewDBClientFromConnector(&sqlmockConnector{dsn: "execute_as_role", drv: db.Driver()}),
+		},
+	}
+
+	resourceDataSchema := map[string]*schema.Schema{
+		"region":          {Type: schema.TypeString, Optional: true},
+		"execute_as_role": {Type: schema.TypeString, Optional: true},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceDataSchema, map[string]interface{}{"execute_as_role": "analyst"})
+
+	roleDb, _, err := GetDBClientFromMeta(providerMeta, resourceData)
+	require.NoError(t, err)
+
+	// The connection switches to the role before running the statement
+	mock.ExpectExec(`SET ROLE "analyst";`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE VIEW`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err = roleDb.Exec(`CREATE VIEW "materialize"."public"."view" AS SELECT 1;`)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// The handle is shared by every resource executing as the role
+	again, _, err := GetDBClientFromMeta(providerMeta, resourceData)
+	require.NoError(t, err)
+	assert.Same(t, roleDb, again)
+}
+
+func TestGetDBClientFromMetaExecuteAsRoleUnsupported(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	providerMeta := &ProviderMeta{
+		Mode: ModeSelfHosted,
+		DB: map[clients.Region]*clients.DBClient{
+			"self-hosted": {DB: sqlx.NewDb(db, "sqlmock")},
+		},
+	}
+
+	resourceDataSchema := map[string]*schema.Schema{
+		"region":          {Type: schema.TypeString, Optional: true},
+		"execute_as_role": {Type: schema.TypeString, Optional: true},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceDataSchema, map[string]interface{}{"execute_as_role": "analyst"})
+
+	_, _, err = GetDBClientFromMeta(providerMeta, resourceData)
+	require.ErrorContains(t, err, "execute_as_role and session_options are not supported")
+}
+
+func TestGetDBClientFromMetaSessionOptions(t *testing.T) {
+	db, mock, err := sqlmock.NewWithDSN("session_options")
+	require.NoError(t, err)
+	defer db.Close()
+
+	providerMeta := &ProviderMeta{
+		Mode: ModeSelfHosted,
+		DB: map[clients.Region]*clients.DBClient{
+			"self-hosted": clients.NewDBClientFromConnector(&sqlmockConnector{dsn: "session_options", drv: db.Driver()}),
+		},
+	}
+
+	resourceDataSchema := map[string]*schema.Schema{
+		"region":          {Type: schema.TypeString, Optional: true},
+		"execute_as_role": {Type: schema.TypeString, Optional: true},
+		"session_options": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceDataSchema, map[string]interface{}{
+		"execute_as_role": "analyst",
+		"session_options": map[string]interface{}{
+			"statement_timeout": "5min",
+			"search_path":       "analytics, public",
+		},
+	})
+
+	sessionDb, _, err := GetDBClientFromMeta(providerMeta, resourceData)
+	require.NoError(t, err)
+
+	// The role is switched first, then the options are set in order of their key
+	mock.ExpectExec(`SET ROLE "analyst";`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SET search_path = 'analytics', 'public';`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SET statement_timeout = '5min';`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE VIEW`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err = sessionDb.Exec(`CREATE VIEW "materialize"."public"."view" AS SELECT 1;`)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// reservedOptionKeys are connection parameters the provider manages itself.
+// Accepting them via the provider `options` or the `session_options` of a
+// resource would either conflict with a dedicated schema field
+// (application_name) or break Materialize outright (transaction_isolation must
+// remain `strict serializable`).
+//
+// Keys here MUST be lowercase. Postgres GUC names are case-insensitive, so the
+// validator lowercases incoming keys before looking them up.
+var reservedOptionKeys = map[string]string{
+	"transaction_isolation": "Materialize requires `transaction_isolation=strict serializable`; overriding it will break the provider.",
+	"application_name":      "`application_name` is set by the provider.",
+}
+
+var optionKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateSessionOptions validates the keys and values of the provider
+// `options` and of the `session_options` of resources.
+func ValidateSessionOptions(v interface{}, p cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	raw, ok := v.(map[string]interface{})
+	if !ok {
+		return diags
+	}
+	for k, val := range raw {
+		if reason, reserved := reservedOptionKeys[strings.ToLower(k)]; reserved {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("option %q is reserved", k),
+				Detail:   reason,
+			})
+			continue
+		}
+		if !optionKeyPattern.MatchString(k) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("invalid option key %q", k),
+				Detail:   "Option keys must start with a letter or underscore and contain only letters, digits, or underscores.",
+			})
+		}
+		if _, ok := val.(string); !ok && val != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("invalid option value for %q", k),
+				Detail:   "Option values must be strings.",
+			})
+		}
+	}
+	return diags
+}